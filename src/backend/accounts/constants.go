@@ -0,0 +1,4 @@
+package accounts
+
+// warmAttempts caps retries per request while warming sessions
+const warmAttempts = 5
@@ -0,0 +1,9 @@
+package accounts
+
+// WarmResult is how one account's session warm-up went, Err says why it failed
+type WarmResult struct {
+	Account    string
+	Reused     bool
+	Unverified bool
+	Err        error
+}
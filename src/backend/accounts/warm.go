@@ -0,0 +1,127 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	backend "popmart/src/backend"
+	helpers "popmart/src/middleware/helpers"
+	desktop "popmart/src/middleware/modules/desktop"
+)
+
+// WarmSessions logs every account in a group in concurrently through the proxy group and saves their sessions,
+// accounts whose saved session still validates are left alone
+func WarmSessions(ctx context.Context, logger *helpers.ColorizedLogger, groupName, proxyGroupName string) ([]WarmResult, error) {
+	accountGroups, err := backend.LoadAccountGroups()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load account groups: %w", err)
+	}
+
+	proxyGroups, err := backend.LoadProxyGroups()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load proxy groups: %w", err)
+	}
+
+	settings, err := backend.LoadSettings()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load settings: %w", err)
+	}
+
+	var accounts, proxies []string
+	for _, g := range accountGroups {
+		if g.Name == groupName {
+			accounts = g.Accounts
+		}
+	}
+	for _, g := range proxyGroups {
+		if g.Name == proxyGroupName {
+			proxies = g.Proxies
+		}
+	}
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("account group not found or empty: %s", groupName)
+	}
+	if len(proxies) == 0 {
+		return nil, fmt.Errorf("proxy group not found or empty: %s", proxyGroupName)
+	}
+
+	// A warm-up shouldn't sit on a dead account for the 50000 attempts a drop would
+	retry := settings.Retry.Override(helpers.RetryPolicy{MaxAttempts: warmAttempts})
+
+	results := make([]WarmResult, len(accounts))
+	sem := make(chan struct{}, helpers.CalculateWorkers())
+	var wg sync.WaitGroup
+
+	logger.Info(fmt.Sprintf("Warming %d Sessions In Account Group %s", len(accounts), groupName))
+	for i, account := range accounts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				results[i] = WarmResult{Account: accountEmail(account), Err: ctx.Err()}
+				return
+			}
+
+			task := helpers.Task{
+				TaskId:       fmt.Sprintf("Warm-%d", i+1),
+				AccountGroup: groupName,
+				ProxyGroup:   proxyGroupName,
+				Proxies:      proxies,
+				Account:      account,
+				Retry:        retry,
+			}
+
+			reused, unverified, err := desktop.WarmSession(ctx, task, logger)
+			results[i] = WarmResult{Account: accountEmail(account), Reused: reused, Unverified: unverified, Err: err}
+			switch {
+			case err != nil:
+				logger.Error(fmt.Sprintf("Task %s: Failed To Warm Session For %s [%s]", task.TaskId, results[i].Account, err.Error()))
+			case unverified:
+				logger.Warn(fmt.Sprintf("Task %s: Session Warmed For %s, Login Response Marked It Unverified", task.TaskId, results[i].Account))
+			case reused:
+				logger.Info(fmt.Sprintf("Task %s: Saved Session Still Valid For %s", task.TaskId, results[i].Account))
+			default:
+				logger.Silly(fmt.Sprintf("Task %s: Session Warmed For %s", task.TaskId, results[i].Account))
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// LogWarmResults prints a summary of a warm-up followed by every account that failed, returning how many did
+func LogWarmResults(logger *helpers.ColorizedLogger, results []WarmResult) int {
+	var reused, warmed, failed int
+	for _, r := range results {
+		switch {
+		case r.Err != nil:
+			failed++
+		case r.Reused:
+			reused++
+		default:
+			warmed++
+		}
+	}
+
+	logger.Info(fmt.Sprintf("Session Warm-Up Finished: %d Logged In | %d Already Valid | %d Failed", warmed, reused, failed))
+	for _, r := range results {
+		switch {
+		case r.Err != nil:
+			logger.Warn(fmt.Sprintf("%s: %s", r.Account, r.Err.Error()))
+		case r.Unverified:
+			// Still counted as warmed, the flag hasn't been shown to stop a checkout
+			logger.Warn(fmt.Sprintf("%s: Marked Unverified By Login, May Need A Verification Code", r.Account))
+		}
+	}
+	return failed
+}
+
+func accountEmail(account string) string {
+	return strings.SplitN(account, ":", 2)[0]
+}
@@ -0,0 +1,34 @@
+package backend
+
+import (
+	"strings"
+
+	storage "popmart/src/middleware/helpers/storage"
+)
+
+var ProxyStore = GroupStore[ProxyGroup]{
+	Document: storage.ProxiesFile,
+	Kind:     "proxy",
+	Name:     func(g *ProxyGroup) *string { return &g.Name },
+	ID:       func(g *ProxyGroup) *string { return &g.ID },
+	Entries:  func(g *ProxyGroup) *[]string { return &g.Proxies },
+	Mask: func(proxy string) string {
+		parts := strings.SplitN(proxy, ":", 4)
+		if len(parts) == 4 {
+			return strings.Join(parts[:3], ":") + ":****"
+		}
+		return proxy
+	},
+}
+
+var AccountStore = GroupStore[AccountGroup]{
+	Document: storage.AccountsFile,
+	Kind:     "account",
+	Name:     func(g *AccountGroup) *string { return &g.Name },
+	ID:       func(g *AccountGroup) *string { return &g.ID },
+	Entries:  func(g *AccountGroup) *[]string { return &g.Accounts },
+	Mask: func(account string) string {
+		email, _, _ := strings.Cut(account, ":")
+		return email
+	},
+}
@@ -0,0 +1,189 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	storage "popmart/src/middleware/helpers/storage"
+
+	"github.com/google/uuid"
+)
+
+// --------------- GROUP STORE --------------- \\
+func (s GroupStore[T]) Load() ([]T, error) {
+	data, err := storage.Read(s.Document)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []T
+	err = json.Unmarshal(data, &groups)
+	return groups, err
+}
+
+func (s GroupStore[T]) Names() ([]string, error) {
+	groups, err := s.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(groups))
+	for i := range groups {
+		names[i] = *s.Name(&groups[i])
+	}
+	return names, nil
+}
+
+func (s GroupStore[T]) View(name string) ([]string, error) {
+	groups, err := s.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	i := s.index(groups, name)
+	if i == -1 {
+		return nil, fmt.Errorf("%s group %q does not exist", s.Kind, name)
+	}
+
+	var lines []string
+	for _, entry := range *s.Entries(&groups[i]) {
+		lines = append(lines, s.Mask(entry))
+	}
+	return lines, nil
+}
+
+// Add saves a new group, like Rename it refuses a blank name or one another group already has
+func (s GroupStore[T]) Add(name string, entries []string) error {
+	return s.update(func(groups []T) ([]T, error) {
+		if err := s.checkName(groups, name); err != nil {
+			return nil, err
+		}
+
+		var group T
+		*s.Name(&group) = name
+		*s.ID(&group) = uuid.New().String()
+		*s.Entries(&group) = entries
+		return append(groups, group), nil
+	})
+}
+
+// Available tells whether name could be given to a new group, so a name can be turned down before its entries are typed in
+func (s GroupStore[T]) Available(name string) error {
+	groups, err := s.Load()
+	if err != nil {
+		return err
+	}
+	return s.checkName(groups, name)
+}
+
+func (s GroupStore[T]) Rename(name, newName string) error {
+	return s.update(func(groups []T) ([]T, error) {
+		i, err := s.findFree(groups, name, newName)
+		if err != nil {
+			return nil, err
+		}
+
+		*s.Name(&groups[i]) = newName
+		return groups, nil
+	})
+}
+
+// Duplicate copies a group under a new name and ID, its entries are copied rather than shared
+func (s GroupStore[T]) Duplicate(name, newName string) error {
+	return s.update(func(groups []T) ([]T, error) {
+		i, err := s.findFree(groups, name, newName)
+		if err != nil {
+			return nil, err
+		}
+
+		duplicate := groups[i]
+		*s.Name(&duplicate) = newName
+		*s.ID(&duplicate) = uuid.New().String()
+		*s.Entries(&duplicate) = slices.Clone(*s.Entries(&groups[i]))
+		return append(groups, duplicate), nil
+	})
+}
+
+func (s GroupStore[T]) Merge(target string, sources []string) error {
+	return s.update(func(groups []T) ([]T, error) {
+		t := s.index(groups, target)
+		if t == -1 {
+			return nil, fmt.Errorf("%s group %q does not exist", s.Kind, target)
+		}
+
+		entries := s.Entries(&groups[t])
+		for _, source := range sources {
+			if source == target {
+				return nil, fmt.Errorf("can't merge %s group %q into itself", s.Kind, source)
+			}
+
+			i := s.index(groups, source)
+			if i == -1 {
+				return nil, fmt.Errorf("%s group %q does not exist", s.Kind, source)
+			}
+
+			for _, entry := range *s.Entries(&groups[i]) {
+				if !slices.Contains(*entries, entry) {
+					*entries = append(*entries, entry)
+				}
+			}
+		}
+
+		return slices.DeleteFunc(groups, func(g T) bool {
+			return slices.Contains(sources, *s.Name(&g))
+		}), nil
+	})
+}
+
+func (s GroupStore[T]) Delete(name string) error {
+	return s.update(func(groups []T) ([]T, error) {
+		i := s.index(groups, name)
+		if i == -1 {
+			return nil, fmt.Errorf("%s group %q does not exist", s.Kind, name)
+		}
+		return slices.Delete(groups, i, i+1), nil
+	})
+}
+
+// update reads, edits and writes the document as one storage update
+func (s GroupStore[T]) update(edit func(groups []T) ([]T, error)) error {
+	return storage.Update(s.Document, func(data []byte) ([]byte, error) {
+		var groups []T
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &groups); err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", s.Document, err)
+			}
+		}
+
+		groups, err := edit(groups)
+		if err != nil {
+			return nil, err
+		}
+		return json.MarshalIndent(groups, "", "  ")
+	})
+}
+
+func (s GroupStore[T]) index(groups []T, name string) int {
+	return slices.IndexFunc(groups, func(g T) bool { return *s.Name(&g) == name })
+}
+
+// findFree returns name's index once it's sure newName is a usable name nothing else has
+func (s GroupStore[T]) findFree(groups []T, name, newName string) (int, error) {
+	i := s.index(groups, name)
+	if i == -1 {
+		return -1, fmt.Errorf("%s group %q does not exist", s.Kind, name)
+	}
+	return i, s.checkName(groups, newName)
+}
+
+func (s GroupStore[T]) checkName(groups []T, name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("the new %s group name is empty", s.Kind)
+	}
+	if s.index(groups, name) != -1 {
+		return fmt.Errorf("%s group %q already exists", s.Kind, name)
+	}
+	return nil
+}
@@ -1,34 +1,338 @@
-package profiles
-
-import (
-	"fmt"
-	"os"
-	"os/exec"
-	"path/filepath"
-	"runtime"
-)
-
-func OpenProfilesCSV() error {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return fmt.Errorf("failed to resolve user home directory: %w", err)
-	}
-
-	profilesPath := filepath.Join(home, "Popmart CLI", "profiles.csv")
-
-	if _, err := os.Stat(profilesPath); os.IsNotExist(err) {
-		return fmt.Errorf("profiles.csv does not exist at %s", profilesPath)
-	}
-
-	var cmd *exec.Cmd
-	switch runtime.GOOS {
-	case "darwin":
-		cmd = exec.Command("open", profilesPath)
-	case "windows":
-		cmd = exec.Command("cmd", "/C", "start", "", profilesPath)
-	default:
-		cmd = exec.Command("xdg-open", profilesPath)
-	}
-
-	return cmd.Run()
-}
+package profiles
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"slices"
+	"strings"
+
+	backend "popmart/src/backend"
+	tasks "popmart/src/backend/tasks"
+	helpers "popmart/src/middleware/helpers"
+	storage "popmart/src/middleware/helpers/storage"
+)
+
+// OpenProfilesCSV opens profiles.csv in the default app, wait is called before saving it back when it lives in the database
+func OpenProfilesCSV(logger *helpers.ColorizedLogger, wait func()) error {
+	return backend.EditDocument(logger, storage.ProfilesFile, wait)
+}
+
+// ----------------------- PROFILE GROUPS ----------------------- \\
+func (Groups) Names() ([]string, error) {
+	records, err := loadProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, group := range column(records, helpers.ProfileGroupColumn) {
+		if group != "" && !slices.Contains(names, group) {
+			names = append(names, group)
+		}
+	}
+	return names, nil
+}
+
+func (Groups) View(name string) ([]string, error) {
+	records, err := loadProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	// Built by hand rather than with tasks.BuildProfile so a file still missing required columns can be looked at
+	index, _ := tasks.ProfileIndex(records[0])
+	var groupProfiles []helpers.Profile
+	for _, row := range records[1:] {
+		if tasks.Column(row, index, helpers.ProfileGroupColumn) != name {
+			continue
+		}
+
+		var profile helpers.Profile
+		for _, column := range helpers.ProfileColumns {
+			if column.Set != nil {
+				column.Set(&profile, tasks.Column(row, index, column.Names[0]))
+			}
+		}
+		groupProfiles = append(groupProfiles, profile)
+	}
+
+	if len(groupProfiles) == 0 {
+		return nil, fmt.Errorf("profile group %q does not exist", name)
+	}
+
+	var lines []string
+	for _, profile := range groupProfiles {
+		lines = append(lines, Summary(profile))
+	}
+	return lines, nil
+}
+
+func (Groups) Rename(name, newName string) error {
+	return updateProfiles(func(headers []string, rows [][]string) ([]string, [][]string, error) {
+		group, err := findFree(headers, rows, name, newName)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, row := range rows {
+			if cell(row, group) == name {
+				row[group] = newName
+			}
+		}
+		return headers, rows, nil
+	})
+}
+
+func (Groups) Duplicate(name, newName string) error {
+	return updateProfiles(func(headers []string, rows [][]string) ([]string, [][]string, error) {
+		group, err := findFree(headers, rows, name, newName)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, row := range rows {
+			if cell(row, group) == name {
+				duplicate := slices.Clone(row)
+				duplicate[group] = newName
+				rows = append(rows, duplicate)
+			}
+		}
+		return headers, rows, nil
+	})
+}
+
+// Merge moves the profiles of sources into target, a profile whose name target already has is dropped
+func (Groups) Merge(target string, sources []string) error {
+	return updateProfiles(func(headers []string, rows [][]string) ([]string, [][]string, error) {
+		index, _ := tasks.ProfileIndex(headers)
+		group, nameColumn := index[helpers.ProfileGroupColumn], index["Profile Name"]
+
+		names := make(map[string]bool)
+		for _, row := range rows {
+			if cell(row, group) == target {
+				names[cell(row, nameColumn)] = true
+			}
+		}
+		if len(names) == 0 {
+			return nil, nil, fmt.Errorf("profile group %q does not exist", target)
+		}
+
+		for _, source := range sources {
+			if source == target {
+				return nil, nil, fmt.Errorf("can't merge profile group %q into itself", source)
+			}
+			if !slices.ContainsFunc(rows, func(row []string) bool { return cell(row, group) == source }) {
+				return nil, nil, fmt.Errorf("profile group %q does not exist", source)
+			}
+		}
+
+		merged := rows[:0]
+		for _, row := range rows {
+			if slices.Contains(sources, cell(row, group)) {
+				if names[cell(row, nameColumn)] {
+					continue
+				}
+				names[cell(row, nameColumn)] = true
+				row[group] = target
+			}
+			merged = append(merged, row)
+		}
+		return headers, merged, nil
+	})
+}
+
+func (Groups) Delete(name string) error {
+	return updateProfiles(func(headers []string, rows [][]string) ([]string, [][]string, error) {
+		index, _ := tasks.ProfileIndex(headers)
+		group := index[helpers.ProfileGroupColumn]
+
+		kept := slices.DeleteFunc(rows, func(row []string) bool { return cell(row, group) == name })
+		if len(kept) == len(rows) {
+			return nil, nil, fmt.Errorf("profile group %q does not exist", name)
+		}
+		return headers, kept, nil
+	})
+}
+
+// Summary is the one line a profile is shown as, the card number is cut down to its last four digits
+func Summary(profile helpers.Profile) string {
+	parts := []string{profile.ProfileName, profile.Email, strings.TrimSpace(profile.Shipping.City + " " + profile.Shipping.State)}
+	if card := strings.ReplaceAll(profile.CardNumber, " ", ""); len(card) >= 4 {
+		parts = append(parts, "Card ****"+card[len(card)-4:])
+	}
+	if profile.Payment != "" {
+		parts = append(parts, profile.Payment)
+	}
+	return strings.Join(parts, " | ")
+}
+
+// ----------------------- PROFILE EDITOR ----------------------- \\
+// Profiles returns the profiles of a group as column values keyed by each column's main name, in file order
+func Profiles(group string) ([]map[string]string, error) {
+	records, err := loadProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	index, _ := tasks.ProfileIndex(records[0])
+	var profiles []map[string]string
+	for _, row := range records[1:] {
+		if tasks.Column(row, index, helpers.ProfileGroupColumn) != group {
+			continue
+		}
+
+		values := make(map[string]string)
+		for _, column := range helpers.ProfileColumns {
+			values[column.Names[0]] = tasks.Column(row, index, column.Names[0])
+		}
+		profiles = append(profiles, values)
+	}
+	return profiles, nil
+}
+
+// SaveProfile writes values over the group's profile at position, or appends a new one when position is -1. Columns
+// the file doesn't have yet are added to the header. It returns the profile's row in profiles.csv
+func SaveProfile(group string, position int, values map[string]string) (int, error) {
+	var rowNum int
+	err := updateProfiles(func(headers []string, rows [][]string) ([]string, [][]string, error) {
+		index, _ := tasks.ProfileIndex(headers)
+		for _, column := range helpers.ProfileColumns {
+			if _, ok := index[column.Names[0]]; !ok && values[column.Names[0]] != "" {
+				index[column.Names[0]] = len(headers)
+				headers = append(headers, column.Names[0])
+			}
+		}
+
+		i := len(rows)
+		if position == -1 {
+			rows = append(rows, nil)
+		} else if i = groupRow(rows, index[helpers.ProfileGroupColumn], group, position); i == -1 {
+			return nil, nil, fmt.Errorf("profile %d of group %q does not exist", position+1, group)
+		}
+
+		row := rows[i]
+		if len(row) < len(headers) {
+			row = append(row, make([]string, len(headers)-len(row))...)
+		}
+		for name, value := range values {
+			if column, ok := index[name]; ok {
+				row[column] = strings.TrimSpace(value)
+			}
+		}
+		row[index[helpers.ProfileGroupColumn]] = group
+
+		rows[i] = row
+		rowNum = i + 2
+		return headers, rows, nil
+	})
+	return rowNum, err
+}
+
+func DeleteProfile(group string, position int) error {
+	return updateProfiles(func(headers []string, rows [][]string) ([]string, [][]string, error) {
+		index, _ := tasks.ProfileIndex(headers)
+		i := groupRow(rows, index[helpers.ProfileGroupColumn], group, position)
+		if i == -1 {
+			return nil, nil, fmt.Errorf("profile %d of group %q does not exist", position+1, group)
+		}
+		return headers, slices.Delete(rows, i, i+1), nil
+	})
+}
+
+// ----------------------- CSV HELPERS ----------------------- \\
+func loadProfiles() ([][]string, error) {
+	records, err := tasks.LoadCsv(storage.ProfilesFile)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("profiles.csv is empty or missing headers")
+	}
+	return records, nil
+}
+
+// updateProfiles reads, edits and writes profiles.csv as one storage update
+func updateProfiles(edit func(headers []string, rows [][]string) ([]string, [][]string, error)) error {
+	return storage.Update(storage.ProfilesFile, func(data []byte) ([]byte, error) {
+		reader := csv.NewReader(bytes.NewReader(data))
+		reader.FieldsPerRecord = -1
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read profiles.csv: %w", err)
+		}
+		if len(records) == 0 {
+			return nil, fmt.Errorf("profiles.csv is empty or missing headers")
+		}
+
+		index, _ := tasks.ProfileIndex(records[0])
+		if _, ok := index[helpers.ProfileGroupColumn]; !ok {
+			return nil, fmt.Errorf("missing '%s' column in profiles.csv", helpers.ProfileGroupColumn)
+		}
+
+		headers, rows, err := edit(records[0], records[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		// A column added by the edit has to reach every row, Validate treats a row shorter than the header as an error
+		for i, row := range rows {
+			if len(row) < len(headers) {
+				rows[i] = append(row, make([]string, len(headers)-len(row))...)
+			}
+		}
+
+		var buf bytes.Buffer
+		writer := csv.NewWriter(&buf)
+		writer.Write(headers)
+		writer.WriteAll(rows)
+		return buf.Bytes(), writer.Error()
+	})
+}
+
+// findFree returns the group column once it's sure name exists and newName is a usable name nothing else has
+func findFree(headers []string, rows [][]string, name, newName string) (int, error) {
+	index, _ := tasks.ProfileIndex(headers)
+	group := index[helpers.ProfileGroupColumn]
+
+	if !slices.ContainsFunc(rows, func(row []string) bool { return cell(row, group) == name }) {
+		return -1, fmt.Errorf("profile group %q does not exist", name)
+	}
+	if strings.TrimSpace(newName) == "" {
+		return -1, fmt.Errorf("the new profile group name is empty")
+	}
+	if slices.ContainsFunc(rows, func(row []string) bool { return cell(row, group) == newName }) {
+		return -1, fmt.Errorf("profile group %q already exists", newName)
+	}
+	return group, nil
+}
+
+// groupRow returns the index in rows of the group's profile at position
+func groupRow(rows [][]string, groupColumn int, group string, position int) int {
+	for i, row := range rows {
+		if cell(row, groupColumn) != group {
+			continue
+		}
+		if position == 0 {
+			return i
+		}
+		position--
+	}
+	return -1
+}
+
+func column(records [][]string, name string) []string {
+	index, _ := tasks.ProfileIndex(records[0])
+	var values []string
+	for _, row := range records[1:] {
+		values = append(values, tasks.Column(row, index, name))
+	}
+	return values
+}
+
+func cell(row []string, i int) string {
+	if i < len(row) {
+		return strings.TrimSpace(row[i])
+	}
+	return ""
+}
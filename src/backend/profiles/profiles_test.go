@@ -0,0 +1,40 @@
+package profiles
+
+import (
+	"testing"
+
+	tasks "popmart/src/backend/tasks"
+	storage "popmart/src/middleware/helpers/storage"
+)
+
+func TestSaveProfilePadsEveryRow(t *testing.T) {
+	if err := storage.Configure(t.TempDir(), ""); err != nil {
+		t.Fatal(err)
+	}
+
+	csv := "Profile Group Name,Profile Name,Email\nMain,One,one@example.com\nMain,Two,two@example.com\nOther,Three,three@example.com\n"
+	if err := storage.Write(storage.ProfilesFile, []byte(csv)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := SaveProfile("Main", 1, map[string]string{"Payment Method": "Paypal"}); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := tasks.LoadCsv(storage.ProfilesFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := records[0][len(records[0])-1]; got != "Payment Method" {
+		t.Fatalf("last header = %q, want Payment Method", got)
+	}
+	for i, row := range records {
+		if len(row) != len(records[0]) {
+			t.Errorf("row %d has %d columns, header has %d", i+1, len(row), len(records[0]))
+		}
+	}
+	if got := records[2][len(records[2])-1]; got != "Paypal" {
+		t.Errorf("edited profile Payment Method = %q, want Paypal", got)
+	}
+}
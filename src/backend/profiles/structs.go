@@ -0,0 +1,4 @@
+package profiles
+
+// Groups manages the profile groups in profiles.csv, it's the backend.GroupManager for profiles
+type Groups struct{}
@@ -1,19 +1,46 @@
-package backend
-
-type AccountGroup struct {
-	Name     string   `json:"name"`
-	ID       string   `json:"id"`
-	Accounts []string `json:"accounts"`
-}
-
-type ProxyGroup struct {
-	Name    string   `json:"name"`
-	ID      string   `json:"id"`
-	Proxies []string `json:"proxies"`
-}
-
-type Settings struct {
-	ImapEmail    string `json:"imapEmail"`
-	ImapPassword string `json:"imapPassword"`
-	WebhookUrl   string `json:"webhookUrl"`
-}
+package backend
+
+import helpers "popmart/src/middleware/helpers"
+
+type AccountGroup struct {
+	Name     string   `json:"name"`
+	ID       string   `json:"id"`
+	Accounts []string `json:"accounts"`
+}
+
+type ProxyGroup struct {
+	Name    string   `json:"name"`
+	ID      string   `json:"id"`
+	Proxies []string `json:"proxies"`
+}
+
+type Settings struct {
+	ImapEmail    string                `json:"imapEmail"`
+	ImapPassword string                `json:"imapPassword"`
+	WebhookUrl   string                `json:"webhookUrl"`
+	Retry        helpers.RetrySettings `json:"retry"`
+	// Keywords turns keyword Input on, the search request hasn't been checked against live traffic so it's off by default
+	Keywords bool `json:"keywords,omitempty"`
+}
+
+// GroupManager is what the menus and CLI need to list and edit proxy, account and profile groups the same way
+type GroupManager interface {
+	Names() ([]string, error)
+	// View returns one line per entry, with passwords and card numbers masked
+	View(name string) ([]string, error)
+	Rename(name, newName string) error
+	Duplicate(name, newName string) error
+	// Merge moves every entry of sources into target, skipping duplicates, and deletes the sources
+	Merge(target string, sources []string) error
+	Delete(name string) error
+}
+
+// GroupStore keeps a list of named groups in one JSON document, the accessors reach the fields the groups share
+type GroupStore[T any] struct {
+	Document string
+	Kind     string
+	Name     func(group *T) *string
+	ID       func(group *T) *string
+	Entries  func(group *T) *[]string
+	Mask     func(entry string) string
+}
@@ -1,29 +1,30 @@
-package tasks
-
-import (
-	"os"
-	"path/filepath"
-
-	backend "popmart/src/backend"
-)
-
-var (
-	ProxyGroups   []backend.ProxyGroup
-	AccountGroups []backend.AccountGroup
-	TasksPath     string
-	ProxiesPath   string
-	ProfilesPath  string
-	AccountsPath  string
-)
-
-func init() {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return
-	}
-
-	TasksPath = filepath.Join(home, "Popmart CLI", "tasks.csv")
-	ProxiesPath = filepath.Join(home, "Popmart CLI", "proxies.json")
-	ProfilesPath = filepath.Join(home, "Popmart CLI", "profiles.csv")
-	AccountsPath = filepath.Join(home, "Popmart CLI", "accounts.json")
-}
+package tasks
+
+import (
+	"time"
+
+	backend "popmart/src/backend"
+)
+
+const (
+	progressInterval = 30 * time.Second
+	defaultWarmUp    = 2 * time.Minute
+)
+
+var (
+	ProxyGroups   []backend.ProxyGroup
+	AccountGroups []backend.AccountGroup
+)
+
+// Columns tasks.csv can't do without, LoadTasks and Validate both check for these. Profile columns live in helpers.ProfileColumns
+var (
+	requiredTaskColumns = []string{
+		"Task Group", "Site", "Mode", "Input", "Size",
+		"Proxy Group", "Profile Group", "Account Group", "Quantity",
+		"Payment Method",
+	}
+	paymentMethods = []string{"Card", "Paypal"}
+
+	// groupColumns name a group from another file, ReferenceIssues reports these after groups change
+	groupColumns = []string{"Proxy Group", "Profile Group", "Account Group"}
+)
@@ -0,0 +1,133 @@
+package tasks
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ----------------------- START TIME ----------------------- \\
+// ParseStartTime resolves a Start Time value to the next release after now, it accepts
+// RFC3339, "2006-01-02 15:04[:05]", a bare "15:04[:05]" for the next time that clock comes round,
+// or a five field cron expression ("minute hour day month weekday") in local time
+func ParseStartTime(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if len(strings.Fields(value)) == 5 {
+		return nextCron(value, now)
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02 15:04"} {
+		if t, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		clock, err := time.ParseInLocation(layout, value, now.Location())
+		if err != nil {
+			continue
+		}
+
+		t := time.Date(now.Year(), now.Month(), now.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, now.Location())
+		if !t.After(now) {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid start time %q", value)
+}
+
+// nextCron returns the first minute after now that matches a five field cron expression
+func nextCron(expr string, now time.Time) (time.Time, error) {
+	fields := strings.Fields(expr)
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+	var sets [5]map[int]bool
+	for i, field := range fields {
+		set, err := cronField(field, bounds[i][0], bounds[i][1])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+		}
+		sets[i] = set
+	}
+
+	// Sunday can be written as 0 or 7
+	if sets[4][7] {
+		sets[4][0] = true
+	}
+	anyDay, anyWeekday := fields[2] == "*", fields[4] == "*"
+
+	t := now.Truncate(time.Minute).Add(time.Minute)
+	for limit := t.AddDate(1, 0, 1); t.Before(limit); t = t.Add(time.Minute) {
+		if !sets[3][int(t.Month())] || !sets[1][t.Hour()] || !sets[0][t.Minute()] {
+			continue
+		}
+
+		// Like cron, a restricted day of month and weekday match when either one does
+		dayMatch, weekdayMatch := sets[2][t.Day()], sets[4][int(t.Weekday())]
+		switch {
+		case anyDay && anyWeekday:
+		case anyDay && !weekdayMatch, anyWeekday && !dayMatch:
+			continue
+		case !anyDay && !anyWeekday && !dayMatch && !weekdayMatch:
+			continue
+		}
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("cron expression %q never matches", expr)
+}
+
+// cronField expands one cron field such as "*", "5", "1-5", "*/15" or "0,30" into the values it allows
+func cronField(field string, min, max int) (map[int]bool, error) {
+	set := make(map[int]bool)
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if before, after, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.Atoi(after)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid step %q", part)
+			}
+			rangePart, step = before, n
+		}
+
+		lo, hi := min, max
+		if rangePart != "*" {
+			if before, after, ok := strings.Cut(rangePart, "-"); ok {
+				var err1, err2 error
+				lo, err1 = strconv.Atoi(before)
+				hi, err2 = strconv.Atoi(after)
+				if err1 != nil || err2 != nil {
+					return nil, fmt.Errorf("invalid range %q", part)
+				}
+			} else {
+				n, err := strconv.Atoi(rangePart)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value %q", part)
+				}
+				lo, hi = n, n
+				if step > 1 {
+					hi = max
+				}
+			}
+		}
+
+		if lo < min || hi > max || lo > hi {
+			return nil, fmt.Errorf("%q is outside %d-%d", part, min, max)
+		}
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+	return set, nil
+}
@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseStartTime(t *testing.T) {
+	// A Wednesday
+	now := time.Date(2025, time.July, 2, 18, 30, 0, 0, time.UTC)
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2025, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "", want: time.Time{}},
+		{value: "2025-07-04T19:00:00Z", want: at(time.July, 4, 19, 0)},
+		{value: "2025-07-04 19:00", want: at(time.July, 4, 19, 0)},
+		{value: "2025-07-04 19:00:30", want: at(time.July, 4, 19, 0).Add(30 * time.Second)},
+		{value: "19:00", want: at(time.July, 2, 19, 0)},
+		{value: "18:00", want: at(time.July, 3, 18, 0)},
+		{value: "18:30", want: at(time.July, 3, 18, 30)},
+
+		// Cron, minute hour day month weekday
+		{value: "0 19 * * 5", want: at(time.July, 4, 19, 0)},
+		{value: "0 12 * * 0", want: at(time.July, 6, 12, 0)},
+		{value: "0 12 * * 7", want: at(time.July, 6, 12, 0)},
+		{value: "*/15 * * * *", want: at(time.July, 2, 18, 45)},
+		{value: "0,20 * * * *", want: at(time.July, 2, 19, 0)},
+		{value: "0 9-17 * * 1-5", want: at(time.July, 3, 9, 0)},
+		{value: "0 0 1 * *", want: at(time.August, 1, 0, 0)},
+		{value: "0 10 15 8 *", want: at(time.August, 15, 10, 0)},
+		// A restricted day and weekday match when either does, the 4th is a Friday before the 13th
+		{value: "0 19 13 * 5", want: at(time.July, 4, 19, 0)},
+
+		{value: "tomorrow", wantErr: true},
+		{value: "25:00", wantErr: true},
+		{value: "60 * * * *", wantErr: true},
+		{value: "* * * 13 *", wantErr: true},
+		{value: "*/0 * * * *", wantErr: true},
+		{value: "5-1 * * * *", wantErr: true},
+		{value: "0 0 30 2 *", wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := ParseStartTime(test.value, now)
+		switch {
+		case test.wantErr && err == nil:
+			t.Errorf("ParseStartTime(%q) = %v, want an error", test.value, got)
+		case !test.wantErr && err != nil:
+			t.Errorf("ParseStartTime(%q) failed: %v", test.value, err)
+		case !test.wantErr && !got.Equal(test.want):
+			t.Errorf("ParseStartTime(%q) = %v, want %v", test.value, got, test.want)
+		}
+	}
+}
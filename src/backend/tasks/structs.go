@@ -0,0 +1,41 @@
+package tasks
+
+import "time"
+
+type RunOptions struct {
+	Timeout      time.Duration
+	MaxCheckouts int
+	StartAt      time.Time
+	WarmUp       time.Duration
+}
+
+type RunSummary struct {
+	Succeeded int
+	Failed    int
+	Stopped   int
+}
+
+// Issue is one problem Validate found in tasks.csv or profiles.csv, warnings don't stop a group from starting
+type Issue struct {
+	File    string
+	Row     int
+	Column  string
+	Message string
+	Warning bool
+
+	profileGroup string
+}
+
+// ColumnError is a tasks.csv error that belongs to one column, so Validate can report it there
+type ColumnError struct {
+	Column string
+	Err    error
+}
+
+// profileEntry is what validating tasks needs to know about a profile row
+type profileEntry struct {
+	name    string
+	email   string
+	payment string
+	hasCard bool
+}
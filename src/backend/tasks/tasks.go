@@ -1,197 +1,386 @@
-package tasks
-
-import (
-	"encoding/csv"
-	"fmt"
-	"os"
-	"os/exec"
-	"path/filepath"
-	"runtime"
-
-	helpers "popmart/src/middleware/helpers"
-
-	"github.com/google/uuid"
-)
-
-func OpenTasksCSV() error {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return fmt.Errorf("failed to resolve user home directory: %w", err)
-	}
-
-	tasksPath := filepath.Join(home, "Popmart CLI", "tasks.csv")
-
-	if _, err := os.Stat(tasksPath); os.IsNotExist(err) {
-		return fmt.Errorf("tasks.csv does not exist at %s", tasksPath)
-	}
-
-	var cmd *exec.Cmd
-	switch runtime.GOOS {
-	case "darwin":
-		cmd = exec.Command("open", tasksPath)
-	case "windows":
-		cmd = exec.Command("cmd", "/C", "start", "", tasksPath)
-	default:
-		cmd = exec.Command("xdg-open", tasksPath)
-	}
-
-	return cmd.Run()
-}
-
-// -------------- LOAD TASKS LOGIC -------------- \\
-func LoadTaskGroups() ([]string, error) {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return nil, fmt.Errorf("failed to resolve user home directory: %w", err)
-	}
-
-	path := filepath.Join(home, "Popmart CLI", "tasks.csv")
-
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open tasks.csv: %w", err)
-	}
-	defer file.Close()
-
-	reader := csv.NewReader(file)
-	records, err := reader.ReadAll()
-	if err != nil {
-		return nil, fmt.Errorf("failed to read CSV content: %w", err)
-	}
-
-	if len(records) < 1 {
-		return nil, fmt.Errorf("tasks.csv is empty or missing headers")
-	}
-
-	headers := records[0]
-	taskGroupIndex := -1
-	for i, header := range headers {
-		if header == "Task Group" {
-			taskGroupIndex = i
-			break
-		}
-	}
-	if taskGroupIndex == -1 {
-		return nil, fmt.Errorf(`"Task Group" column not found in tasks.csv`)
-	}
-
-	groupMap := map[string]bool{}
-	for _, row := range records[1:] {
-		if len(row) > taskGroupIndex {
-			group := row[taskGroupIndex]
-			if group != "" {
-				groupMap[group] = true
-			}
-		}
-	}
-
-	var uniqueGroups []string
-	for group := range groupMap {
-		uniqueGroups = append(uniqueGroups, group)
-	}
-
-	return uniqueGroups, nil
-}
-
-func LoadTasks(logger *helpers.ColorizedLogger, groupName string) ([]helpers.Task, error) {
-	if err := LoadJson(ProxiesPath, &ProxyGroups); err != nil {
-		return nil, err
-	}
-
-	if err := LoadJson(AccountsPath, &AccountGroups); err != nil {
-		return nil, err
-	}
-
-	profileRecords, err := LoadCsv(ProfilesPath)
-	if err != nil {
-		return nil, err
-	}
-
-	profileGroups, err := BuildProfile(profileRecords)
-	if err != nil {
-		return nil, err
-	}
-
-	taskRecords, err := LoadCsv(TasksPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(taskRecords) < 2 {
-		return nil, fmt.Errorf("tasks.csv is empty or missing headers")
-	}
-
-	headers := taskRecords[0]
-	indexMap := make(map[string]int)
-	for i, h := range headers {
-		indexMap[h] = i
-	}
-
-	required := []string{
-		"Task Group", "Site", "Mode", "Input", "Size",
-		"Proxy Group", "Profile Group", "Account Group", "Quantity",
-		"Delay", "Payment Method",
-	}
-	for _, col := range required {
-		if _, ok := indexMap[col]; !ok {
-			return nil, fmt.Errorf("missing required column: %s", col)
-		}
-	}
-
-	var tasks []helpers.Task
-
-	for _, row := range taskRecords[1:] {
-		if len(row) < len(headers) || row[indexMap["Task Group"]] != groupName {
-			continue
-		}
-
-		profileGroup := row[indexMap["Profile Group"]]
-		accountGroup := row[indexMap["Account Group"]]
-		proxyGroup := row[indexMap["Proxy Group"]]
-
-		delay := ParseInt(row[indexMap["Delay"]], 3500)
-		quantity := ParseInt(row[indexMap["Quantity"]], 1)
-		size := Normalize(row[indexMap["Size"]])
-
-		pg := FindProxy(ProxyGroups, proxyGroup)
-		if pg == nil {
-			logger.Error(fmt.Sprintf("Proxy Group Not Found: %s", proxyGroup))
-			continue
-		}
-
-		profiles := profileGroups[profileGroup]
-		if len(profiles) == 0 {
-			logger.Error(fmt.Sprintf("Profile Group Not Found Or Empty: %s", profileGroup))
-			continue
-		}
-
-		for _, profile := range profiles {
-			account := FindAccount(AccountGroups, accountGroup, profile.Email)
-			if account == "" {
-				logger.Warn(fmt.Sprintf("No Matching Account For Email: %s", profile.Email))
-				continue
-			}
-
-			tasks = append(tasks, helpers.Task{
-				TaskId:        uuid.New().String(),
-				TaskGroupName: row[indexMap["Task Group"]],
-				Site:          row[indexMap["Site"]],
-				Mode:          row[indexMap["Mode"]],
-				Input:         row[indexMap["Input"]],
-				Size:          size,
-				ProfileGroup:  profileGroup,
-				AccountGroup:  accountGroup,
-				ProxyGroup:    pg.Name,
-				Proxies:       pg.Proxies,
-				Account:       account,
-				Payment:       row[indexMap["Payment Method"]],
-				Quantity:      quantity,
-				Delay:         delay,
-				Profile:       profile,
-			})
-		}
-	}
-
-	logger.Silly(fmt.Sprintf("Loaded %d Tasks From Group %s", len(tasks), groupName))
-	return tasks, nil
-}
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	backend "popmart/src/backend"
+	helpers "popmart/src/middleware/helpers"
+	storage "popmart/src/middleware/helpers/storage"
+	desktop "popmart/src/middleware/modules/desktop"
+
+	"github.com/google/uuid"
+)
+
+// OpenTasksCSV opens tasks.csv in the default app, wait is called before saving it back when it lives in the database
+func OpenTasksCSV(logger *helpers.ColorizedLogger, wait func()) error {
+	return backend.EditDocument(logger, storage.TasksFile, wait)
+}
+
+// -------------- LOAD TASKS LOGIC -------------- \\
+func LoadTaskGroups() ([]string, error) {
+	data, err := storage.Read(storage.TasksFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open tasks.csv: %w", err)
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV content: %w", err)
+	}
+
+	if len(records) < 1 {
+		return nil, fmt.Errorf("tasks.csv is empty or missing headers")
+	}
+
+	headers := records[0]
+	taskGroupIndex := -1
+	for i, header := range headers {
+		if header == "Task Group" {
+			taskGroupIndex = i
+			break
+		}
+	}
+	if taskGroupIndex == -1 {
+		return nil, fmt.Errorf(`"Task Group" column not found in tasks.csv`)
+	}
+
+	groupMap := map[string]bool{}
+	for _, row := range records[1:] {
+		if len(row) > taskGroupIndex {
+			group := row[taskGroupIndex]
+			if group != "" {
+				groupMap[group] = true
+			}
+		}
+	}
+
+	var uniqueGroups []string
+	for group := range groupMap {
+		uniqueGroups = append(uniqueGroups, group)
+	}
+
+	return uniqueGroups, nil
+}
+
+func LoadTasks(logger *helpers.ColorizedLogger, groupName string) ([]helpers.Task, error) {
+	issues, err := Validate(groupName)
+	if err != nil {
+		return nil, err
+	}
+
+	if countErrors(issues) > 0 {
+		errorCount := LogIssues(logger, issues)
+		return nil, fmt.Errorf("task group %s has %d problems in tasks.csv or profiles.csv, fix them or run validate for details", groupName, errorCount)
+	}
+
+	if err := LoadJson(storage.ProxiesFile, &ProxyGroups); err != nil {
+		return nil, err
+	}
+
+	if err := LoadJson(storage.AccountsFile, &AccountGroups); err != nil {
+		return nil, err
+	}
+
+	profileRecords, err := LoadCsv(storage.ProfilesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	profileGroups, err := BuildProfile(profileRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := backend.LoadSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	taskRecords, err := LoadCsv(storage.TasksFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(taskRecords) < 2 {
+		return nil, fmt.Errorf("tasks.csv is empty or missing headers")
+	}
+
+	headers := taskRecords[0]
+	indexMap := make(map[string]int)
+	for i, h := range headers {
+		indexMap[h] = i
+	}
+
+	for _, col := range requiredTaskColumns {
+		if _, ok := indexMap[col]; !ok {
+			return nil, fmt.Errorf("missing required column: %s", col)
+		}
+	}
+
+	var tasks []helpers.Task
+
+	for _, row := range taskRecords[1:] {
+		if len(row) < len(headers) || row[indexMap["Task Group"]] != groupName {
+			continue
+		}
+
+		profileGroup := row[indexMap["Profile Group"]]
+		accountGroup := row[indexMap["Account Group"]]
+		proxyGroup := row[indexMap["Proxy Group"]]
+
+		startAt, err := ParseStartTime(Column(row, indexMap, "Start Time"), time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		items, err := ParseItems(row[indexMap["Input"]], row[indexMap["Size"]], row[indexMap["Quantity"]])
+		if err != nil {
+			logger.Error(fmt.Sprintf("Invalid Task Items: %s", err.Error()))
+			continue
+		}
+
+		// Older task files only have Delay, which used to cover all three, blank delays fall back to settings.json and 0 means no wait
+		legacyDelay := ParseOptionalInt(Column(row, indexMap, "Delay"), nil)
+		stepDelay := ParseInt(Column(row, indexMap, "Step Delay"), 0)
+		if legacyDelay != nil {
+			stepDelay = ParseInt(Column(row, indexMap, "Step Delay"), *legacyDelay)
+		}
+		retry := settings.Retry.Override(helpers.RetryPolicy{
+			MonitorDelay: ParseOptionalInt(Column(row, indexMap, "Monitor Delay"), legacyDelay),
+			ErrorDelay:   ParseOptionalInt(Column(row, indexMap, "Retry Delay"), legacyDelay),
+			MaxAttempts:  ParseInt(Column(row, indexMap, "Max Attempts"), 0),
+			Backoff:      ParseOptionalFloat(Column(row, indexMap, "Backoff")),
+			Jitter:       ParseOptionalFloat(Column(row, indexMap, "Jitter")),
+		})
+
+		if err := validateItems(items, settings.Keywords); err != nil {
+			logger.Error(fmt.Sprintf("Invalid Task Input: %s", err.Error()))
+			continue
+		}
+
+		pg := FindProxy(ProxyGroups, proxyGroup)
+		if pg == nil {
+			logger.Error(fmt.Sprintf("Proxy Group Not Found: %s", proxyGroup))
+			continue
+		}
+
+		profiles := profileGroups[profileGroup]
+		if len(profiles) == 0 {
+			logger.Error(fmt.Sprintf("Profile Group Not Found Or Empty: %s", profileGroup))
+			continue
+		}
+
+		for _, profile := range profiles {
+			account := FindAccount(AccountGroups, accountGroup, profile.Email)
+			if account == "" {
+				logger.Warn(fmt.Sprintf("No Matching Account For Email: %s", profile.Email))
+				continue
+			}
+
+			// A blank Payment Method lets each profile pay its own way
+			payment := Column(row, indexMap, "Payment Method")
+			if payment == "" {
+				payment = profile.Payment
+			}
+
+			tasks = append(tasks, helpers.Task{
+				TaskId:        uuid.New().String(),
+				TaskGroupName: row[indexMap["Task Group"]],
+				Site:          row[indexMap["Site"]],
+				Mode:          row[indexMap["Mode"]],
+				Items:         items,
+				ProfileGroup:  profileGroup,
+				AccountGroup:  accountGroup,
+				ProxyGroup:    pg.Name,
+				Proxies:       pg.Proxies,
+				Account:       account,
+				Payment:       payment,
+				Shipping:      Column(row, indexMap, "Shipping"),
+				DiscountCode:  Column(row, indexMap, "Discount Code"),
+				StepDelay:     stepDelay,
+				MaxPrice:      ParseCents(Column(row, indexMap, "Max Price"), 0),
+				MaxTotal:      ParseCents(Column(row, indexMap, "Max Total"), 0),
+				StartAt:       startAt,
+				Retry:         retry,
+				Profile:       profile,
+			})
+		}
+	}
+
+	logger.Silly(fmt.Sprintf("Loaded %d Tasks From Group %s", len(tasks), groupName))
+	return tasks, nil
+}
+
+// -------------- RUN TASKS LOGIC -------------- \\
+func RunTasks(ctx context.Context, logger *helpers.ColorizedLogger, loadedTasks []helpers.Task, opts RunOptions) RunSummary {
+	startAt := scheduleTasks(loadedTasks, opts.StartAt)
+	if opts.Timeout > 0 {
+		// A scheduled group's timeout counts from the release, not from the warm-up
+		deadline := time.Now().Add(opts.Timeout)
+		if startAt.After(time.Now()) {
+			deadline = startAt.Add(opts.Timeout)
+		}
+
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithDeadline(ctx, deadline)
+		defer cancelTimeout()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	helpers.ResetStatuses()
+	for i := range loadedTasks {
+		loadedTasks[i].Status = helpers.TrackTask(loadedTasks[i])
+	}
+
+	go logProgress(ctx, logger)
+
+	maxWorkers := helpers.CalculateWorkers()
+	if startAt.After(time.Now()) {
+		warmUp := opts.WarmUp
+		if warmUp <= 0 {
+			warmUp = defaultWarmUp
+		}
+
+		warmAt := startAt.Add(-warmUp)
+		logger.Info(fmt.Sprintf("Task Group Scheduled For %s, Warming Up At %s", startAt.Format("2006-01-02 15:04:05"), warmAt.Format("15:04:05")))
+		helpers.Wait(ctx, time.Until(warmAt))
+
+		// Every task has to be logged in and idling before the release, so none can queue behind a worker
+		maxWorkers = max(maxWorkers, len(loadedTasks))
+	}
+	logger.Info(fmt.Sprintf("Starting %d Workers Based On %d CPU Cores", maxWorkers, runtime.NumCPU()))
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		summary RunSummary
+	)
+	tasksChan := make(chan helpers.Task)
+
+	for range make([]struct{}, maxWorkers) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t := range tasksChan {
+				taskCtx, cancelTask := context.WithCancel(ctx)
+				t.Status.Bind(cancelTask)
+
+				var err error
+				switch t.Mode {
+				case "Desktop":
+					err = desktop.PopmartDesktop(taskCtx, t, logger)
+				case "App":
+					logger.Error("App Mode Is Currently Down For Maintenance")
+					err = fmt.Errorf("unsupported mode")
+				default:
+					logger.Warn(fmt.Sprintf("Task %s: Unsupported Task Mode Has Been Declared", t.TaskId))
+					err = fmt.Errorf("unsupported mode")
+				}
+
+				cancelTask()
+				t.Status.Finish(err)
+
+				mu.Lock()
+				switch {
+				case err == nil:
+					summary.Succeeded++
+					if opts.MaxCheckouts > 0 && summary.Succeeded >= opts.MaxCheckouts {
+						logger.Silly(fmt.Sprintf("Reached %d Successful Checkouts, Stopping Task Group", opts.MaxCheckouts))
+						cancel()
+					}
+				case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+					summary.Stopped++
+				default:
+					summary.Failed++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	dispatched := 0
+feed:
+	for _, task := range loadedTasks {
+		select {
+		case tasksChan <- task:
+			dispatched++
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(tasksChan)
+
+	wg.Wait()
+	cancel()
+
+	for _, t := range loadedTasks[dispatched:] {
+		t.Status.Finish(context.Canceled)
+	}
+	summary.Stopped += len(loadedTasks) - dispatched
+	return summary
+}
+
+// LogSummary logs every checkout of the last run with its size and the run's totals. Callers log it once the run's
+// output is back on the terminal, so the dashboard doesn't swallow it
+func LogSummary(logger *helpers.ColorizedLogger, summary RunSummary) {
+	// The webhook isn't the only record of what was bought, every checkout is listed with its size here too
+	for _, status := range helpers.TaskStatuses() {
+		if status.Step == helpers.StepDone && status.Sku != "" {
+			logger.Silly(fmt.Sprintf("Task %s: Checked Out %s [%s]", status.TaskId, status.Product, status.Sku))
+		}
+	}
+	logger.Info(fmt.Sprintf("Task Group Finished: %d Succeeded | %d Failed | %d Stopped", summary.Succeeded, summary.Failed, summary.Stopped))
+}
+
+// scheduleTasks applies an overriding start time to every task and returns the earliest one, zero when nothing is scheduled
+func scheduleTasks(loadedTasks []helpers.Task, override time.Time) time.Time {
+	if !override.IsZero() {
+		for i := range loadedTasks {
+			loadedTasks[i].StartAt = override
+		}
+		return override
+	}
+
+	var earliest time.Time
+	for _, t := range loadedTasks {
+		if !t.StartAt.IsZero() && (earliest.IsZero() || t.StartAt.Before(earliest)) {
+			earliest = t.StartAt
+		}
+	}
+	return earliest
+}
+
+// logProgress periodically logs how many tasks sit in each step until the group finishes
+func logProgress(ctx context.Context, logger *helpers.ColorizedLogger) {
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			counts := helpers.StepCounts()
+			var parts []string
+			for _, step := range append([]helpers.TaskStep{helpers.StepQueued}, helpers.CheckoutSteps...) {
+				if counts[step] > 0 {
+					parts = append(parts, fmt.Sprintf("%s: %d", step, counts[step]))
+				}
+			}
+			if len(parts) > 0 {
+				logger.Info("Task Progress | " + strings.Join(parts, " | "))
+			}
+		}
+	}
+}
@@ -1,113 +1,237 @@
-package tasks
-
-import (
-	"encoding/csv"
-	"encoding/json"
-	"fmt"
-	"math/rand"
-	"os"
-	"strconv"
-	"strings"
-
-	backend "popmart/src/backend"
-	helpers "popmart/src/middleware/helpers"
-)
-
-// ----------------------- UTILITY FUNCS ----------------------- \\
-func ParseInt(val string, def int) int {
-	if parsed, err := strconv.Atoi(val); err == nil {
-		return parsed
-	}
-	return def
-}
-
-func Normalize(input string) string {
-	switch strings.ToLower(strings.TrimSpace(input)) {
-	case "ra", "rand", "random":
-		if rand.Intn(2) == 0 {
-			return "Single box"
-		}
-		return "Whole set"
-	case "single box":
-		return "Single box"
-	case "whole set":
-		return "Whole set"
-	default:
-		return input
-	}
-}
-
-// ----------------------- TASK HELPERS ----------------------- \\
-func LoadJson[T any](path string, target *T) error {
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return err
-	}
-	return json.Unmarshal(data, target)
-}
-
-func LoadCsv(path string) ([][]string, error) {
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
-	return csv.NewReader(file).ReadAll()
-}
-
-func FindProxy(groups []backend.ProxyGroup, name string) *backend.ProxyGroup {
-	for _, g := range groups {
-		if g.Name == name {
-			return &g
-		}
-	}
-	return nil
-}
-
-func FindAccount(groups []backend.AccountGroup, groupName, email string) string {
-	for _, g := range groups {
-		if g.Name == groupName {
-			for _, acc := range g.Accounts {
-				parts := strings.SplitN(acc, ":", 2)
-				if len(parts) == 2 && strings.EqualFold(parts[0], email) {
-					return acc
-				}
-			}
-		}
-	}
-	return ""
-}
-
-func BuildProfile(records [][]string) (map[string][]helpers.Profile, error) {
-	if len(records) < 2 {
-		return nil, fmt.Errorf("profiles.csv is empty or missing headers")
-	}
-
-	headers := records[0]
-	index := -1
-	for i, h := range headers {
-		if h == "Profile Group Name" {
-			index = i
-			break
-		}
-	}
-	if index == -1 {
-		return nil, fmt.Errorf("missing 'Profile Group Name' column in profiles.csv")
-	}
-
-	grouped := make(map[string][]helpers.Profile)
-	for _, row := range records[1:] {
-		if len(row) < 15 {
-			continue
-		}
-		group := row[index]
-		profile := helpers.Profile{
-			ProfileName: row[1], Email: row[2], Name: row[3], Phone: row[4],
-			Address1: row[5], Address2: row[6], City: row[7], PostCode: row[8],
-			Country: row[9], State: row[10], CardNumber: row[11], ExpMonth: row[12],
-			ExpYear: row[13], CVV: row[14],
-		}
-		grouped[group] = append(grouped[group], profile)
-	}
-	return grouped, nil
-}
+package tasks
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+
+	backend "popmart/src/backend"
+	helpers "popmart/src/middleware/helpers"
+	storage "popmart/src/middleware/helpers/storage"
+	desktop "popmart/src/middleware/modules/desktop"
+)
+
+// ----------------------- UTILITY FUNCS ----------------------- \\
+func ParseInt(val string, def int) int {
+	if parsed, err := strconv.Atoi(val); err == nil {
+		return parsed
+	}
+	return def
+}
+
+// ParseOptionalInt is ParseInt for settings where 0 means something, a blank or invalid value gives def
+func ParseOptionalInt(val string, def *int) *int {
+	if parsed, err := strconv.Atoi(strings.TrimSpace(val)); err == nil {
+		return &parsed
+	}
+	return def
+}
+
+func ParseFloat(val string, def float64) float64 {
+	if parsed, err := strconv.ParseFloat(strings.TrimSpace(val), 64); err == nil {
+		return parsed
+	}
+	return def
+}
+
+// ParseOptionalFloat is ParseFloat for settings where 0 means something, a blank or invalid value gives nil
+func ParseOptionalFloat(val string) *float64 {
+	if parsed, err := strconv.ParseFloat(strings.TrimSpace(val), 64); err == nil {
+		return &parsed
+	}
+	return nil
+}
+
+// ParseCents reads a dollar amount such as "29.99" into cents, blank or invalid values give def
+func ParseCents(val string, def int) int {
+	if parsed, err := strconv.ParseFloat(strings.TrimPrefix(strings.TrimSpace(val), "$"), 64); err == nil {
+		return int(math.Round(parsed * 100))
+	}
+	return def
+}
+
+// Column returns a row's value for an optional column, or an empty string when tasks.csv doesn't have it
+func Column(row []string, indexMap map[string]int, name string) string {
+	if i, ok := indexMap[name]; ok && i < len(row) {
+		return strings.TrimSpace(row[i])
+	}
+	return ""
+}
+
+// ParseItems splits the ";" separated Input, Size and Quantity cells into cart lines, a single Size or Quantity applies to every item
+func ParseItems(input, size, quantity string) ([]helpers.TaskItem, error) {
+	inputs := strings.Split(input, ";")
+	sizes := strings.Split(size, ";")
+	quantities := strings.Split(quantity, ";")
+	if len(sizes) != 1 && len(sizes) != len(inputs) {
+		return nil, &ColumnError{Column: "Size", Err: fmt.Errorf("%d sizes given for %d items", len(sizes), len(inputs))}
+	}
+	if len(quantities) != 1 && len(quantities) != len(inputs) {
+		return nil, &ColumnError{Column: "Quantity", Err: fmt.Errorf("%d quantities given for %d items", len(quantities), len(inputs))}
+	}
+
+	items := make([]helpers.TaskItem, len(inputs))
+	for i, in := range inputs {
+		items[i] = helpers.TaskItem{
+			Input:    strings.TrimSpace(in),
+			Size:     NormalizeSizes(sizes[min(i, len(sizes)-1)]),
+			Quantity: ParseInt(strings.TrimSpace(quantities[min(i, len(quantities)-1)]), 1),
+		}
+	}
+	return items, nil
+}
+
+func (e *ColumnError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ColumnError) Unwrap() error {
+	return e.Err
+}
+
+// validateItems checks every item's Input reads as a spuId, product URL or keywords, keywords only once they're turned on
+func validateItems(items []helpers.TaskItem, keywords bool) error {
+	for _, item := range items {
+		query, err := desktop.ParseInput(item.Input)
+		if err != nil {
+			return err
+		}
+		if query.SpuId == "" && !keywords {
+			return fmt.Errorf("keyword input %q is experimental, run settings set keywords on to use it", item.Input)
+		}
+	}
+	return nil
+}
+
+// NormalizeSizes normalizes each size in a "|" separated preference list such as "Whole set|Single box"
+func NormalizeSizes(input string) string {
+	sizes := strings.Split(input, "|")
+	for i, size := range sizes {
+		sizes[i] = Normalize(size)
+	}
+	return strings.Join(sizes, "|")
+}
+
+func Normalize(input string) string {
+	switch strings.ToLower(strings.TrimSpace(input)) {
+	case "any":
+		return "any"
+	case "ra", "rand", "random":
+		if rand.Intn(2) == 0 {
+			return "Single box"
+		}
+		return "Whole set"
+	case "single box":
+		return "Single box"
+	case "whole set":
+		return "Whole set"
+	default:
+		return input
+	}
+}
+
+// ----------------------- TASK HELPERS ----------------------- \\
+func LoadJson[T any](name string, target *T) error {
+	data, err := storage.Read(name)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// LoadCsv reads a stored CSV, rows may be shorter or longer than the header so Validate can point at them
+func LoadCsv(name string) ([][]string, error) {
+	data, err := storage.Read(name)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	return reader.ReadAll()
+}
+
+func FindProxy(groups []backend.ProxyGroup, name string) *backend.ProxyGroup {
+	for _, g := range groups {
+		if g.Name == name {
+			return &g
+		}
+	}
+	return nil
+}
+
+func FindAccount(groups []backend.AccountGroup, groupName, email string) string {
+	for _, g := range groups {
+		if g.Name == groupName {
+			for _, acc := range g.Accounts {
+				parts := strings.SplitN(acc, ":", 2)
+				if len(parts) == 2 && strings.EqualFold(parts[0], email) {
+					return acc
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// BuildProfile groups profiles.csv rows by profile group, reading each field by its header so columns can be in any order
+func BuildProfile(records [][]string) (map[string][]helpers.Profile, error) {
+	if len(records) < 2 {
+		return nil, fmt.Errorf("profiles.csv is empty or missing headers")
+	}
+
+	index, _ := ProfileIndex(records[0])
+	for _, column := range helpers.ProfileColumns {
+		if _, ok := index[column.Names[0]]; column.Required && !ok {
+			return nil, fmt.Errorf("missing '%s' column in profiles.csv", column.Names[0])
+		}
+	}
+
+	grouped := make(map[string][]helpers.Profile)
+	for _, row := range records[1:] {
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+
+		var profile helpers.Profile
+		for _, column := range helpers.ProfileColumns {
+			if column.Set != nil {
+				column.Set(&profile, Column(row, index, column.Names[0]))
+			}
+		}
+
+		group := Column(row, index, helpers.ProfileGroupColumn)
+		grouped[group] = append(grouped[group], profile)
+	}
+	return grouped, nil
+}
+
+// ProfileIndex maps each profile column's main name to its position in headers, matching aliases and ignoring case.
+// Headers it doesn't know are returned so they can be flagged
+func ProfileIndex(headers []string) (map[string]int, []string) {
+	index := make(map[string]int)
+	var unknown []string
+	for i, header := range headers {
+		header = strings.TrimSpace(header)
+		found := false
+		for _, column := range helpers.ProfileColumns {
+			if slices.ContainsFunc(column.Names, func(name string) bool { return strings.EqualFold(name, header) }) {
+				if _, seen := index[column.Names[0]]; !seen {
+					index[column.Names[0]] = i
+				}
+				found = true
+				break
+			}
+		}
+		if !found && header != "" {
+			unknown = append(unknown, header)
+		}
+	}
+	return index, unknown
+}
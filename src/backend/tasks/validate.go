@@ -0,0 +1,412 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	backend "popmart/src/backend"
+	helpers "popmart/src/middleware/helpers"
+	storage "popmart/src/middleware/helpers/storage"
+	desktop "popmart/src/middleware/modules/desktop"
+)
+
+// ----------------------- VALIDATION ----------------------- \\
+// Validate checks tasks.csv and profiles.csv by column name and returns every problem it finds. With a group it only
+// looks at that task group's rows and the profile groups they use, an empty group checks both files in full
+func Validate(group string) ([]Issue, error) {
+	taskRecords, err := LoadCsv(storage.TasksFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tasks.csv: %w", err)
+	}
+
+	profileRecords, err := LoadCsv(storage.ProfilesFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profiles.csv: %w", err)
+	}
+
+	var proxyGroups []backend.ProxyGroup
+	if err := LoadJson(storage.ProxiesFile, &proxyGroups); err != nil {
+		return nil, fmt.Errorf("failed to read proxies.json: %w", err)
+	}
+
+	var accountGroups []backend.AccountGroup
+	if err := LoadJson(storage.AccountsFile, &accountGroups); err != nil {
+		return nil, fmt.Errorf("failed to read accounts.json: %w", err)
+	}
+
+	settings, err := backend.LoadSettings()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read settings.json: %w", err)
+	}
+
+	profiles, profileIssues := validateProfiles(profileRecords, time.Now())
+	issues, used := validateTasks(taskRecords, group, profiles, proxyGroups, accountGroups, settings.Keywords)
+
+	for _, issue := range profileIssues {
+		// Header problems affect every group, row problems only the groups using that profile group
+		if group == "" || issue.Row == 1 || used[issue.profileGroup] {
+			issues = append(issues, issue)
+		}
+	}
+	return issues, nil
+}
+
+// ReferenceIssues returns the tasks.csv rows naming a proxy, profile or account group that doesn't exist, it's run
+// after a group is renamed, merged away or deleted
+func ReferenceIssues() ([]Issue, error) {
+	issues, err := Validate("")
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(issues, func(issue Issue) bool {
+		return issue.File != storage.TasksFile || !slices.Contains(groupColumns, issue.Column)
+	}), nil
+}
+
+// LogIssues logs every issue and returns how many of them are errors
+func LogIssues(logger *helpers.ColorizedLogger, issues []Issue) int {
+	for _, issue := range issues {
+		if issue.Warning {
+			logger.Warn(issue.String())
+		} else {
+			logger.Error(issue.String())
+		}
+	}
+	return countErrors(issues)
+}
+
+func (i Issue) String() string {
+	location := fmt.Sprintf("%s Row %d", i.File, i.Row)
+	if i.Column != "" {
+		location += fmt.Sprintf(" [%s]", i.Column)
+	}
+	return fmt.Sprintf("%s: %s", location, i.Message)
+}
+
+func countErrors(issues []Issue) int {
+	count := 0
+	for _, issue := range issues {
+		if !issue.Warning {
+			count++
+		}
+	}
+	return count
+}
+
+// validateTasks checks the task rows in group (every row when it's empty) and returns the profile groups they use
+func validateTasks(records [][]string, group string, profiles map[string][]profileEntry, proxyGroups []backend.ProxyGroup, accountGroups []backend.AccountGroup, keywords bool) ([]Issue, map[string]bool) {
+	var issues []Issue
+	used := make(map[string]bool)
+	report := func(row int, column, format string, args ...any) {
+		issues = append(issues, Issue{File: storage.TasksFile, Row: row, Column: column, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(records) == 0 {
+		report(1, "", "file is empty, it needs a header row")
+		return issues, used
+	}
+
+	headers := records[0]
+	indexMap := make(map[string]int)
+	for i, h := range headers {
+		indexMap[strings.TrimSpace(h)] = i
+	}
+	for _, col := range requiredTaskColumns {
+		if _, ok := indexMap[col]; !ok {
+			report(1, col, "required column is missing")
+		}
+	}
+
+	for i, row := range records[1:] {
+		rowNum := i + 2
+		taskGroup := Column(row, indexMap, "Task Group")
+		if group != "" && taskGroup != group {
+			continue
+		}
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+
+		if len(row) < len(headers) {
+			report(rowNum, "", "row has %d columns but the header has %d", len(row), len(headers))
+		}
+		if taskGroup == "" {
+			report(rowNum, "Task Group", "task group is empty")
+		}
+
+		items, err := ParseItems(Column(row, indexMap, "Input"), Column(row, indexMap, "Size"), Column(row, indexMap, "Quantity"))
+		var columnErr *ColumnError
+		if errors.As(err, &columnErr) {
+			report(rowNum, columnErr.Column, "%s", columnErr.Error())
+		} else if err != nil {
+			report(rowNum, "Input", "%s", err.Error())
+		} else if err := validateItems(items, keywords); err != nil {
+			report(rowNum, "Input", "%s", err.Error())
+		}
+
+		for _, quantity := range strings.Split(Column(row, indexMap, "Quantity"), ";") {
+			if n, err := strconv.Atoi(strings.TrimSpace(quantity)); strings.TrimSpace(quantity) != "" && (err != nil || n < 1) {
+				report(rowNum, "Quantity", "%q is not a whole number above 0", quantity)
+			}
+		}
+
+		payment := Column(row, indexMap, "Payment Method")
+		if payment != "" && !slices.Contains(paymentMethods, payment) {
+			report(rowNum, "Payment Method", "unknown payment method %q, expected %s", payment, strings.Join(paymentMethods, " or "))
+		}
+
+		proxyGroup := Column(row, indexMap, "Proxy Group")
+		if FindProxy(proxyGroups, proxyGroup) == nil {
+			report(rowNum, "Proxy Group", "proxy group %q does not exist", proxyGroup)
+		}
+
+		profileGroup := Column(row, indexMap, "Profile Group")
+		used[profileGroup] = true
+		groupProfiles := profiles[profileGroup]
+		if len(groupProfiles) == 0 {
+			report(rowNum, "Profile Group", "profile group %q does not exist or has no profiles", profileGroup)
+		}
+
+		accountGroup := Column(row, indexMap, "Account Group")
+		if !slices.ContainsFunc(accountGroups, func(g backend.AccountGroup) bool { return g.Name == accountGroup }) {
+			report(rowNum, "Account Group", "account group %q does not exist", accountGroup)
+		} else {
+			for _, profile := range groupProfiles {
+				if FindAccount(accountGroups, accountGroup, profile.email) == "" {
+					issues = append(issues, Issue{
+						File: storage.TasksFile, Row: rowNum, Column: "Account Group", Warning: true,
+						Message: fmt.Sprintf("no account for %s (profile %s) in %q, that profile's task is skipped", profile.email, profile.name, accountGroup),
+					})
+				}
+			}
+		}
+
+		// A blank Payment Method falls back to each profile's own
+		for _, profile := range groupProfiles {
+			method := payment
+			if method == "" {
+				method = profile.payment
+			}
+
+			switch {
+			case method == "":
+				report(rowNum, "Payment Method", "payment method is empty here and in profile %s", profile.name)
+			case method == "Card" && !profile.hasCard:
+				report(rowNum, "Payment Method", "profile %s has no card to pay with", profile.name)
+			}
+		}
+
+		if _, err := ParseStartTime(Column(row, indexMap, "Start Time"), time.Now()); err != nil {
+			report(rowNum, "Start Time", "%s", err.Error())
+		}
+
+		for _, col := range []string{"Max Price", "Max Total"} {
+			if value := Column(row, indexMap, col); value != "" && ParseCents(value, -1) < 0 {
+				report(rowNum, col, "%q is not a dollar amount", value)
+			}
+		}
+
+		for _, col := range []string{"Delay", "Monitor Delay", "Retry Delay", "Step Delay", "Max Attempts"} {
+			if value := Column(row, indexMap, col); value != "" && ParseInt(value, -1) < 0 {
+				report(rowNum, col, "%q is not a whole number", value)
+			}
+		}
+
+		for _, col := range []string{"Backoff", "Jitter"} {
+			if value := Column(row, indexMap, col); value != "" && ParseFloat(value, -1) < 0 {
+				report(rowNum, col, "%q is not a number", value)
+			}
+		}
+	}
+	return issues, used
+}
+
+// validateProfiles checks every profile row and groups what validating tasks needs by profile group
+func validateProfiles(records [][]string, now time.Time) (map[string][]profileEntry, []Issue) {
+	var issues []Issue
+	profiles := make(map[string][]profileEntry)
+
+	if len(records) == 0 {
+		issues = append(issues, Issue{File: storage.ProfilesFile, Row: 1, Message: "file is empty, it needs a header row"})
+		return profiles, issues
+	}
+
+	headers := records[0]
+	indexMap, unknown := ProfileIndex(headers)
+	for _, column := range helpers.ProfileColumns {
+		if _, ok := indexMap[column.Names[0]]; column.Required && !ok {
+			issues = append(issues, Issue{File: storage.ProfilesFile, Row: 1, Column: column.Names[0], Message: "required column is missing"})
+		}
+	}
+	for _, header := range unknown {
+		issues = append(issues, Issue{File: storage.ProfilesFile, Row: 1, Column: header, Message: "unknown column is ignored", Warning: true})
+	}
+
+	for i, row := range records[1:] {
+		rowNum := i + 2
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+
+		get := func(col string) string { return Column(row, indexMap, col) }
+		group := get(helpers.ProfileGroupColumn)
+		report := func(column, format string, args ...any) {
+			issues = append(issues, Issue{File: storage.ProfilesFile, Row: rowNum, Column: column, Message: fmt.Sprintf(format, args...), profileGroup: group})
+		}
+
+		if len(row) < len(headers) {
+			report("", "row has %d columns but the header has %d", len(row), len(headers))
+		}
+
+		for _, column := range helpers.ProfileColumns {
+			if column.Required && get(column.Names[0]) == "" {
+				report(column.Names[0], "%s is empty", strings.ToLower(column.Names[0]))
+			}
+		}
+
+		if method := get("Payment Method"); method != "" && !slices.Contains(paymentMethods, method) {
+			report("Payment Method", "unknown payment method %q, expected %s", method, strings.Join(paymentMethods, " or "))
+		}
+
+		if code := get("Phone Country Code"); code != "" {
+			if digits := desktop.RemoveNonDigits(code); digits == "" || len(digits) > 4 {
+				report("Phone Country Code", "%q is not a dialing code like +1", code)
+			}
+		}
+
+		// Billing columns are all or nothing, a partial billing address would be sent with the card. Only US
+		// addresses need a state, BillingAddress passes other countries' states through to Adyen as written
+		billingUS := desktop.CountryCode(get("Billing Country")) == "US"
+		billing := []string{"Billing Name", "Billing Address 1", "Billing Address 2", "Billing City", "Billing Post Code", "Billing Country", "Billing State"}
+		if slices.ContainsFunc(billing, func(col string) bool { return get(col) != "" }) {
+			for _, col := range []string{"Billing Address 1", "Billing City", "Billing Post Code", "Billing State"} {
+				if get(col) == "" && (col != "Billing State" || billingUS) {
+					report(col, "%s is empty but other billing columns are filled in", strings.ToLower(col))
+				}
+			}
+		}
+
+		for _, col := range []string{"Country", "Billing Country"} {
+			if err := validateCountry(get(col)); err != nil {
+				report(col, "%s", err.Error())
+			}
+		}
+		if country := desktop.CountryCode(get("Country")); country != "" && country != "US" {
+			report("Country", "orders only ship to the US, not %s", country)
+		}
+
+		if state := get("Billing State"); state != "" && billingUS {
+			if err := validateState(state); err != nil {
+				report("Billing State", "%s", err.Error())
+			}
+		}
+
+		if email := get("Email"); email != "" {
+			if _, err := mail.ParseAddress(email); err != nil {
+				report("Email", "%q is not a valid email", email)
+			}
+		}
+
+		// AddAddress sends a one word name with an empty last name, which Popmart takes, so this only warns
+		if name := get("Name"); name != "" && !strings.Contains(name, " ") {
+			issues = append(issues, Issue{File: storage.ProfilesFile, Row: rowNum, Column: "Name", Message: fmt.Sprintf("%q has no last name", name), Warning: true, profileGroup: group})
+		}
+
+		if phone := desktop.RemoveNonDigits(get("Phone")); get("Phone") != "" && (len(phone) < 10 || len(phone) > 15) {
+			report("Phone", "%q is not a valid phone number", get("Phone"))
+		}
+
+		if state := get("State"); state != "" {
+			if err := validateState(state); err != nil {
+				report("State", "%s", err.Error())
+			}
+		}
+
+		hasCard := get("Card Number") != ""
+		if hasCard {
+			validateCard(get, now, func(column, message string) { report(column, "%s", message) })
+		}
+
+		if group != "" {
+			profiles[group] = append(profiles[group], profileEntry{name: get("Profile Name"), email: get("Email"), payment: get("Payment Method"), hasCard: hasCard})
+		}
+	}
+	return profiles, issues
+}
+
+// validateState accepts the full state names address lookups use
+func validateState(state string) error {
+	if _, ok := helpers.StateAbbreviations[state]; ok {
+		return nil
+	}
+
+	for name, code := range helpers.StateAbbreviations {
+		if strings.EqualFold(name, state) || strings.EqualFold(code, state) {
+			return fmt.Errorf("unknown state %q, write it as %q", state, name)
+		}
+	}
+	return fmt.Errorf("unknown state %q", state)
+}
+
+// validateCountry accepts a blank country, a two letter code or a name for the US, anything else would be sent as the US
+func validateCountry(country string) error {
+	if strings.TrimSpace(country) == "" || desktop.CountryCode(country) != "" {
+		return nil
+	}
+	return fmt.Errorf("unknown country %q, write it as a two letter code like US", country)
+}
+
+// validateCard checks the card number's Luhn digit, that it hasn't expired and the security code's length
+func validateCard(get func(col string) string, now time.Time, report func(column, message string)) {
+	number := desktop.RemoveNonDigits(get("Card Number"))
+	if len(number) < 12 || len(number) > 19 || !luhn(number) {
+		report("Card Number", "card number fails the Luhn check")
+	}
+
+	month, err := strconv.Atoi(get("Expiration Month"))
+	if err != nil || month < 1 || month > 12 {
+		report("Expiration Month", fmt.Sprintf("%q is not a month from 1 to 12", get("Expiration Month")))
+		month = 0
+	}
+
+	year, err := strconv.Atoi(get("Expiration Year"))
+	if err != nil || year < 0 {
+		report("Expiration Year", fmt.Sprintf("%q is not a year", get("Expiration Year")))
+	} else if month != 0 {
+		if year < 100 {
+			year += 2000
+		}
+		// Cards are good through the last day of their expiry month
+		if !time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, now.Location()).After(now) {
+			report("Expiration Year", fmt.Sprintf("card expired %02d/%d", month, year))
+		}
+	}
+
+	cvv := get("Security Code")
+	if _, err := strconv.Atoi(cvv); err != nil || len(cvv) < 3 || len(cvv) > 4 {
+		report("Security Code", fmt.Sprintf("%q is not a 3 or 4 digit security code", cvv))
+	}
+}
+
+func luhn(number string) bool {
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		digit := int(number[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
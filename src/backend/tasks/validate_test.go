@@ -0,0 +1,191 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	storage "popmart/src/middleware/helpers/storage"
+)
+
+func TestValidateCard(t *testing.T) {
+	now := time.Date(2025, time.July, 2, 12, 0, 0, 0, time.UTC)
+	valid := map[string]string{"Card Number": "4111 1111 1111 1111", "Expiration Month": "07", "Expiration Year": "2025", "Security Code": "123"}
+
+	tests := []struct {
+		name   string
+		change map[string]string
+		want   []string
+	}{
+		{name: "valid card", change: nil, want: nil},
+		{name: "two digit year", change: map[string]string{"Expiration Year": "29"}, want: nil},
+		{name: "four digit security code", change: map[string]string{"Security Code": "1234"}, want: nil},
+		{name: "luhn failure", change: map[string]string{"Card Number": "4111111111111112"}, want: []string{"Card Number"}},
+		{name: "too short", change: map[string]string{"Card Number": "4111"}, want: []string{"Card Number"}},
+		{name: "expired last month", change: map[string]string{"Expiration Month": "6"}, want: []string{"Expiration Year"}},
+		{name: "expired last year", change: map[string]string{"Expiration Year": "2024", "Expiration Month": "12"}, want: []string{"Expiration Year"}},
+		{name: "bad month", change: map[string]string{"Expiration Month": "13"}, want: []string{"Expiration Month"}},
+		{name: "short security code", change: map[string]string{"Security Code": "12"}, want: []string{"Security Code"}},
+		{name: "long security code", change: map[string]string{"Security Code": "12345"}, want: []string{"Security Code"}},
+		{name: "letters in security code", change: map[string]string{"Security Code": "12a"}, want: []string{"Security Code"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			row := make(map[string]string)
+			for col, value := range valid {
+				row[col] = value
+			}
+			for col, value := range test.change {
+				row[col] = value
+			}
+
+			var got []string
+			validateCard(func(col string) string { return row[col] }, now, func(column, message string) {
+				got = append(got, column)
+			})
+
+			if strings.Join(got, ",") != strings.Join(test.want, ",") {
+				t.Errorf("reported columns = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestValidateState(t *testing.T) {
+	tests := []struct {
+		state   string
+		wantErr string
+	}{
+		{state: "New York"},
+		{state: "California"},
+		{state: "NY", wantErr: `write it as "New York"`},
+		{state: "new york", wantErr: `write it as "New York"`},
+		{state: "Nowhere", wantErr: `unknown state "Nowhere"`},
+	}
+
+	for _, test := range tests {
+		err := validateState(test.state)
+		switch {
+		case test.wantErr == "" && err != nil:
+			t.Errorf("validateState(%q) = %v, want nil", test.state, err)
+		case test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)):
+			t.Errorf("validateState(%q) = %v, want an error containing %q", test.state, err, test.wantErr)
+		}
+	}
+}
+
+func TestValidateCountry(t *testing.T) {
+	tests := []struct {
+		country string
+		wantErr bool
+	}{
+		{country: ""},
+		{country: "US"},
+		{country: "USA"},
+		{country: "United States"},
+		{country: "CA"},
+		{country: "Canada", wantErr: true},
+		{country: "Nowhere", wantErr: true},
+	}
+
+	for _, test := range tests {
+		if err := validateCountry(test.country); (err != nil) != test.wantErr {
+			t.Errorf("validateCountry(%q) = %v, want error %v", test.country, err, test.wantErr)
+		}
+	}
+}
+
+func TestValidateProfilesBillingState(t *testing.T) {
+	now := time.Date(2025, time.July, 2, 12, 0, 0, 0, time.UTC)
+	header := []string{"Profile Group Name", "Profile Name", "Email", "Name", "Phone", "Address 1", "City", "Post Code", "State", "Billing Address 1", "Billing City", "Billing Post Code", "Billing Country", "Billing State"}
+	shipping := []string{"Main", "One", "one@example.com", "Jane Doe", "5555555555", "1 Main St", "Albany", "12207", "New York"}
+
+	tests := []struct {
+		name    string
+		billing []string
+		want    string
+	}{
+		{name: "us with state", billing: []string{"1 Main St", "Albany", "12207", "US", "New York"}},
+		{name: "us without state", billing: []string{"1 Main St", "Albany", "12207", "US", ""}, want: "Billing State"},
+		{name: "us with abbreviation", billing: []string{"1 Main St", "Albany", "12207", "US", "NY"}, want: "Billing State"},
+		{name: "canada without state", billing: []string{"1 Queen St", "Toronto", "M5H 2N2", "CA", ""}},
+		{name: "canada with province", billing: []string{"1 Queen St", "Toronto", "M5H 2N2", "CA", "ON"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			row := append(append([]string{}, shipping...), test.billing...)
+			_, issues := validateProfiles([][]string{header, row}, now)
+
+			var got []string
+			for _, issue := range issues {
+				if !issue.Warning {
+					got = append(got, issue.Column)
+				}
+			}
+			if strings.Join(got, ",") != test.want {
+				t.Errorf("reported columns = %v, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := storage.Configure(t.TempDir(), ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// Profile headers written with aliases, in their own order
+	profilesCsv := "Group,Profile,Email Address,Full Name,Phone Number,Address1,City,Zip,Province,Card,Exp Month,Exp Year,CVV,Payment\n" +
+		"Main,One,one@example.com,Jane Doe,5555555555,1 Main St,Albany,12207,New York,4111111111111111,12,2099,123,Card\n"
+	documents := map[string]string{
+		storage.ProfilesFile: profilesCsv,
+		storage.ProxiesFile:  `[{"name":"Resi","id":"1","proxies":["127.0.0.1:8080"]}]`,
+		storage.AccountsFile: `[{"name":"Accounts","id":"1","accounts":["one@example.com:password"]}]`,
+		storage.SettingsFile: `{}`,
+	}
+	for name, data := range documents {
+		if err := storage.Write(name, []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	header := "Task Group,Site,Mode,Input,Size,Proxy Group,Profile Group,Account Group,Quantity,Payment Method\n"
+	tests := []struct {
+		name string
+		row  string
+		want string
+	}{
+		{name: "aliased profile headers", row: "Drop,US,Desktop,1234,Random,Resi,Main,Accounts,1,\n"},
+		{name: "size count", row: "Drop,US,Desktop,1234;5678,S;M;L,Resi,Main,Accounts,1,\n", want: "Size"},
+		{name: "quantity count", row: "Drop,US,Desktop,1234;5678,Random,Resi,Main,Accounts,1;2;3,\n", want: "Quantity"},
+		{name: "keywords while off", row: "Drop,US,Desktop,+labubu,Random,Resi,Main,Accounts,1,\n", want: "Input"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := storage.Write(storage.TasksFile, []byte(header+test.row)); err != nil {
+				t.Fatal(err)
+			}
+
+			issues, err := Validate("")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, issue := range issues {
+				if !issue.Warning {
+					got = append(got, issue.String())
+				}
+			}
+			switch {
+			case test.want == "" && len(got) > 0:
+				t.Errorf("Validate reported %v, want no errors", got)
+			case test.want != "" && (len(got) != 1 || !strings.Contains(got[0], "["+test.want+"]")):
+				t.Errorf("Validate reported %v, want one error under %s", got, test.want)
+			}
+		})
+	}
+}
@@ -1,98 +1,147 @@
-package backend
-
-import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"os"
-	"os/exec"
-	"path/filepath"
-	"runtime"
-	"time"
-)
-
-// --------------- UTILITY FUNCTIONS --------------- \\
-func OpenInEditor(filename string) error {
-	var cmd *exec.Cmd
-
-	switch runtime.GOOS {
-	case "darwin":
-		cmd = exec.Command("open", filename)
-	case "windows":
-		cmd = exec.Command("notepad", filename)
-	default:
-		cmd = exec.Command("xdg-open", filename)
-	}
-
-	return cmd.Run()
-}
-
-// --------------- PROXY FUNCTIONS --------------- \\
-func LoadProxyGroups() ([]ProxyGroup, error) {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return nil, err
-	}
-	path := filepath.Join(home, "Popmart CLI", "proxies.json")
-
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return nil, err
-	}
-
-	var groups []ProxyGroup
-	err = json.Unmarshal(data, &groups)
-	return groups, err
-}
-
-// --------------- SETTINGS FUNCTIONS --------------- \\
-func ParseIntColor(hex string) int {
-	var intValue int
-	_, err := fmt.Sscanf(hex, "#%06x", &intValue)
-	if err != nil {
-		return 0
-	}
-	return intValue
-}
-
-func LoadSettings() (Settings, error) {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return Settings{}, err
-	}
-
-	path := filepath.Join(home, "Popmart CLI", "settings.json")
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return Settings{}, err
-	}
-
-	var settings Settings
-	err = json.Unmarshal(data, &settings)
-	if err != nil {
-		return Settings{}, err
-	}
-	return settings, nil
-}
-
-func SendWebhook(url string, data map[string]any) {
-	jsonData, err := json.Marshal(data)
-	if err != nil {
-		return
-	}
-
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	client := &http.Client{Timeout: 5 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return
-	}
-	defer resp.Body.Close()
-}
+package backend
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"slices"
+	"time"
+
+	helpers "popmart/src/middleware/helpers"
+	storage "popmart/src/middleware/helpers/storage"
+)
+
+// --------------- UTILITY FUNCTIONS --------------- \\
+func OpenInEditor(filename string) error {
+	var cmd *exec.Cmd
+
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", filename)
+	case "windows":
+		cmd = exec.Command("notepad", filename)
+	default:
+		cmd = exec.Command("xdg-open", filename)
+	}
+
+	return cmd.Run()
+}
+
+// OpenFile opens a file in the app the OS associates with it, without waiting for it to close
+func OpenFile(path string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", path)
+	case "windows":
+		cmd = exec.Command("cmd", "/C", "start", "", path)
+	default:
+		cmd = exec.Command("xdg-open", path)
+	}
+
+	return cmd.Run()
+}
+
+// EditDocument opens a stored document in its default app, a document that only lives in the database or vault
+// is copied to a temp file first and saved back once wait returns, the copy is deleted however this returns
+func EditDocument(logger *helpers.ColorizedLogger, name string, wait func()) error {
+	store, err := storage.Current()
+	if err != nil {
+		return err
+	}
+
+	if path, ok := store.Path(name); ok {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return fmt.Errorf("%s does not exist at %s", name, path)
+		}
+		return OpenFile(path)
+	}
+
+	data, err := store.Read(name)
+	if err != nil {
+		return err
+	}
+
+	tmpPath, remove, err := storage.TempCopy(name, data)
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer remove()
+
+	if encrypted, _ := storage.Encrypted(); encrypted && slices.Contains(storage.Sensitive, name) {
+		logger.Warn(fmt.Sprintf("%s Is Decrypted Into %s While You Edit It, It's Deleted Once You Press Enter", name, tmpPath))
+	}
+
+	if err := OpenFile(tmpPath); err != nil {
+		return err
+	}
+	wait()
+
+	edited, err := os.ReadFile(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to read edited %s: %w", name, err)
+	}
+	if bytes.Equal(edited, data) {
+		return nil
+	}
+	return store.Write(name, edited)
+}
+
+// --------------- PROXY FUNCTIONS --------------- \\
+func LoadProxyGroups() ([]ProxyGroup, error) {
+	return ProxyStore.Load()
+}
+
+// --------------- ACCOUNT FUNCTIONS --------------- \\
+func LoadAccountGroups() ([]AccountGroup, error) {
+	return AccountStore.Load()
+}
+
+// --------------- SETTINGS FUNCTIONS --------------- \\
+func ParseIntColor(hex string) int {
+	var intValue int
+	_, err := fmt.Sscanf(hex, "#%06x", &intValue)
+	if err != nil {
+		return 0
+	}
+	return intValue
+}
+
+func LoadSettings() (Settings, error) {
+	data, err := storage.Read(storage.SettingsFile)
+	if err != nil {
+		return Settings{}, err
+	}
+
+	var settings Settings
+	err = json.Unmarshal(data, &settings)
+	if err != nil {
+		return Settings{}, err
+	}
+	return settings, nil
+}
+
+func SendWebhook(url string, data map[string]any) {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+}
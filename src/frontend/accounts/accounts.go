@@ -1,57 +1,112 @@
-package accounts
-
-import (
-	"fmt"
-
-	accounts "popmart/src/backend/accounts"
-	helpers "popmart/src/middleware/helpers"
-
-	"github.com/AlecAivazis/survey/v2"
-)
-
-func AccountsMenu(logger *helpers.ColorizedLogger) {
-	for {
-		var result string
-		options := []string{
-			"Add Accounts",
-			"Back",
-		}
-
-		prompt := &survey.Select{
-			Message: "Accounts Menu:",
-			Options: options,
-		}
-
-		err := survey.AskOne(prompt, &result)
-		if err != nil {
-			logger.Error(fmt.Sprintf("Failed To Prompt Accounts Menu: %v", err))
-			return
-		}
-
-		switch result {
-		case "Add Accounts":
-			var groupName string
-			namePrompt := &survey.Input{
-				Message: "Enter Account Group Name:",
-			}
-			err := survey.AskOne(namePrompt, &groupName)
-			if err != nil {
-				logger.Error("Prompt Has Failed Or Been Cancelled: " + err.Error())
-				continue
-			}
-
-			err = accounts.AddAccountGroup(logger, groupName)
-			if err != nil {
-				logger.Error("Failed To Add Account Group: " + err.Error())
-				continue
-			}
-			logger.Silly("Successfully Saved Account Group ✅")
-
-		case "Back":
-			return
-
-		default:
-			logger.Warn("Invalid option selected")
-		}
-	}
-}
+package accounts
+
+import (
+	"context"
+	"fmt"
+
+	backend "popmart/src/backend"
+	accounts "popmart/src/backend/accounts"
+	groups "popmart/src/frontend/groups"
+	helpers "popmart/src/middleware/helpers"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+func AccountsMenu(logger *helpers.ColorizedLogger) {
+	for {
+		var result string
+		options := []string{
+			"Add Accounts",
+			"Warm Sessions",
+			"Manage Account Groups",
+			"Back",
+		}
+
+		prompt := &survey.Select{
+			Message: "Accounts Menu:",
+			Options: options,
+		}
+
+		err := survey.AskOne(prompt, &result)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Failed To Prompt Accounts Menu: %v", err))
+			return
+		}
+
+		switch result {
+		case "Add Accounts":
+			var groupName string
+			namePrompt := &survey.Input{
+				Message: "Enter Account Group Name:",
+			}
+			err := survey.AskOne(namePrompt, &groupName)
+			if err != nil {
+				logger.Error("Prompt Has Failed Or Been Cancelled: " + err.Error())
+				continue
+			}
+
+			err = accounts.AddAccountGroup(logger, groupName)
+			if err != nil {
+				logger.Error("Failed To Add Account Group: " + err.Error())
+				continue
+			}
+			logger.Silly("Successfully Saved Account Group ✅")
+
+		case "Warm Sessions":
+			accountGroups, err := backend.LoadAccountGroups()
+			if err != nil {
+				logger.Error("Failed To Load Account Groups: " + err.Error())
+				continue
+			}
+
+			proxyGroups, err := backend.LoadProxyGroups()
+			if err != nil {
+				logger.Error("Failed To Load Proxy Groups: " + err.Error())
+				continue
+			}
+
+			if len(accountGroups) == 0 || len(proxyGroups) == 0 {
+				logger.Warn("Add An Account Group And A Proxy Group Before Warming Sessions")
+				continue
+			}
+
+			var accountNames, proxyNames []string
+			for _, g := range accountGroups {
+				accountNames = append(accountNames, g.Name)
+			}
+			for _, g := range proxyGroups {
+				proxyNames = append(proxyNames, g.Name)
+			}
+
+			var groupName, proxyGroup string
+			if err := survey.AskOne(&survey.Select{Message: "Select Account Group To Warm:", Options: accountNames}, &groupName); err != nil {
+				logger.Error("Prompt Has Failed Or Been Cancelled: " + err.Error())
+				continue
+			}
+			if err := survey.AskOne(&survey.Select{Message: "Select Proxy Group To Log In With:", Options: proxyNames}, &proxyGroup); err != nil {
+				logger.Error("Prompt Has Failed Or Been Cancelled: " + err.Error())
+				continue
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			restore := helpers.OnInterrupt(cancel)
+			results, err := accounts.WarmSessions(ctx, logger, groupName, proxyGroup)
+			restore()
+			cancel()
+			if err != nil {
+				logger.Error("Failed To Warm Sessions: " + err.Error())
+				continue
+			}
+			accounts.LogWarmResults(logger, results)
+
+		case "Manage Account Groups":
+			groups.ManageGroups(logger, "Account", backend.AccountStore)
+
+		case "Back":
+			return
+
+		default:
+			logger.Warn("Invalid option selected")
+		}
+	}
+}
@@ -0,0 +1,651 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	backend "popmart/src/backend"
+	accounts "popmart/src/backend/accounts"
+	profiles "popmart/src/backend/profiles"
+	proxies "popmart/src/backend/proxies"
+	setting "popmart/src/backend/settings"
+	tasks "popmart/src/backend/tasks"
+	helpers "popmart/src/middleware/helpers"
+	storage "popmart/src/middleware/helpers/storage"
+)
+
+const (
+	ExitOK      = 0
+	ExitFailure = 1
+	ExitUsage   = 2
+)
+
+// EnvImapPassword holds the IMAP password for settings set imap, so it stays out of shell history and ps
+const EnvImapPassword = "POPMART_IMAP_PASSWORD"
+
+const usage = `Usage: popmart [--data-dir <path>] [--workspace <name>] <command> <subcommand> [flags]
+
+Global Flags:
+  --data-dir <path>                           Keep data here instead of ~/Popmart CLI (or set POPMART_DATA_DIR)
+  --workspace <name>                          Use a separate set of tasks, profiles, proxies, accounts and settings
+                                              (or set POPMART_WORKSPACE)
+
+Commands:
+  tasks list                                  List task groups in tasks.csv
+  tasks start --group <name>                  Run every task in a task group, exits 1 if nothing checked out
+        [--timeout 30m] [--max-checkouts N]
+        [--at "19:00" | --at "0 19 * * 5"] [--warm-up 2m]
+  validate [--group <name>]                   Check tasks.csv and profiles.csv, exits 1 if there are any errors
+  proxies list                                List proxy groups
+  proxies add --group <name> --file <path>    Import host:port:user:pass lines as a proxy group
+  proxies test --group <name>                 Test every proxy in a proxy group
+  proxies view --group <name>                 List a proxy group's proxies with passwords masked
+  proxies rename --group <name> --to <name>   Rename a proxy group
+  proxies duplicate --group <name> --to <name>
+                                              Copy a proxy group under a new name
+  proxies merge --into <name> --group <a,b>   Move the proxies of other groups into one, deleting the others
+  proxies delete --group <name>               Delete a proxy group
+  accounts list                               List account groups
+  accounts add --group <name> --file <path>   Import email:password lines as an account group
+  accounts warm --group <name> --proxies <proxy group>
+                                              Log every account in a group in and save its session, exits 1 if any failed
+  accounts view|rename|duplicate|merge|delete Same as the proxies subcommands, for account groups
+  profiles list                               List profile groups in profiles.csv
+  profiles view|rename|duplicate|merge|delete Same as the proxies subcommands, for profile groups, view masks card numbers
+  settings set webhook <url>                  Save the Discord webhook URL
+  settings set imap <email>                   Save the IMAP credentials, the password is read from
+                                              POPMART_IMAP_PASSWORD or piped in on stdin
+  settings set keywords on|off                Turn experimental keyword Input on, it's off by default
+  settings test-webhook                       Send a test message to the saved webhook
+  vault encrypt                               Encrypt profiles, accounts, sessions and settings, creating the vault
+                                              from POPMART_VAULT_PASSPHRASE the first time (which also unlocks it)
+  workspaces list                             List workspaces in the data directory, * marks the active one
+
+Running popmart with no command opens the interactive menu.
+`
+
+// Configure applies the global flags to storage and returns the arguments after them, it has to run before InitFileSystem
+func Configure(args []string) ([]string, error) {
+	fs := newFlagSet("popmart")
+	fs.Usage = func() {}
+	dataDir := fs.String("data-dir", "", "directory to keep data in")
+	workspace := fs.String("workspace", "", "workspace to use")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return []string{"help"}, nil
+		}
+		fmt.Fprint(os.Stderr, usage)
+		return nil, err
+	}
+
+	if err := storage.Configure(*dataDir, *workspace); err != nil {
+		usageError(err.Error())
+		return nil, err
+	}
+	return fs.Args(), nil
+}
+
+// Run executes a single headless command and returns the process exit code.
+func Run(logger *helpers.ColorizedLogger, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return ExitUsage
+	}
+
+	switch args[0] {
+	case "tasks":
+		return tasksCommand(logger, args[1:])
+	case "validate":
+		return validateCommand(logger, args[1:])
+	case "proxies":
+		return proxiesCommand(logger, args[1:])
+	case "accounts":
+		return accountsCommand(logger, args[1:])
+	case "profiles":
+		return profilesCommand(logger, args[1:])
+	case "settings":
+		return settingsCommand(logger, args[1:])
+	case "vault":
+		return vaultCommand(logger, args[1:])
+	case "workspaces":
+		return workspacesCommand(args[1:])
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+		return ExitOK
+	default:
+		return usageError(fmt.Sprintf("unknown command %q", args[0]))
+	}
+}
+
+// -------------- TASK COMMANDS -------------- \\
+func tasksCommand(logger *helpers.ColorizedLogger, args []string) int {
+	if len(args) == 0 {
+		return usageError("missing tasks subcommand")
+	}
+
+	switch args[0] {
+	case "list":
+		groups, err := tasks.LoadTaskGroups()
+		if err != nil {
+			logger.Error("Failed To Load Task Groups: " + err.Error())
+			return ExitFailure
+		}
+
+		for _, group := range groups {
+			fmt.Println(group)
+		}
+		return ExitOK
+
+	case "start":
+		fs := newFlagSet("tasks start")
+		group := fs.String("group", "", "task group to start")
+		timeout := fs.Duration("timeout", 0, "stop the task group after this long, e.g. 30m")
+		maxCheckouts := fs.Int("max-checkouts", 0, "stop the task group after this many successful checkouts")
+		at := fs.String("at", "", "release time or cron expression, overrides the Start Time column")
+		warmUp := fs.Duration("warm-up", 0, "how long before the release to log in and look up addresses (default 2m)")
+		if err := fs.Parse(args[1:]); err != nil {
+			return ExitUsage
+		}
+		if *group == "" {
+			return usageError("tasks start requires --group")
+		}
+
+		startAt, err := tasks.ParseStartTime(*at, time.Now())
+		if err != nil {
+			return usageError(err.Error())
+		}
+
+		loadedTasks, err := tasks.LoadTasks(logger, *group)
+		if err != nil {
+			logger.Error("Failed To Load Tasks: " + err.Error())
+			return ExitFailure
+		}
+
+		if len(loadedTasks) == 0 {
+			logger.Error(fmt.Sprintf("No Tasks Were Loaded For Group %s", *group))
+			return ExitFailure
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		summary := tasks.RunTasks(ctx, logger, loadedTasks, tasks.RunOptions{
+			Timeout:      *timeout,
+			MaxCheckouts: *maxCheckouts,
+			StartAt:      startAt,
+			WarmUp:       *warmUp,
+		})
+		tasks.LogSummary(logger, summary)
+		if summary.Succeeded == 0 {
+			return ExitFailure
+		}
+		return ExitOK
+
+	default:
+		return usageError(fmt.Sprintf("unknown tasks subcommand %q", args[0]))
+	}
+}
+
+// -------------- VALIDATE COMMAND -------------- \\
+func validateCommand(logger *helpers.ColorizedLogger, args []string) int {
+	fs := newFlagSet("validate")
+	group := fs.String("group", "", "only check this task group and the profile groups it uses")
+	if err := fs.Parse(args); err != nil {
+		return ExitUsage
+	}
+
+	issues, err := tasks.Validate(*group)
+	if err != nil {
+		logger.Error("Failed To Validate: " + err.Error())
+		return ExitFailure
+	}
+
+	errorCount := tasks.LogIssues(logger, issues)
+	if errorCount > 0 {
+		logger.Error(fmt.Sprintf("Found %d Errors And %d Warnings", errorCount, len(issues)-errorCount))
+		return ExitFailure
+	}
+
+	logger.Silly(fmt.Sprintf("tasks.csv And profiles.csv Look Good (%d Warnings) ✅", len(issues)))
+	return ExitOK
+}
+
+// -------------- PROXY COMMANDS -------------- \\
+func proxiesCommand(logger *helpers.ColorizedLogger, args []string) int {
+	if len(args) == 0 {
+		return usageError("missing proxies subcommand")
+	}
+
+	switch args[0] {
+	case "list":
+		groups, err := backend.LoadProxyGroups()
+		if err != nil {
+			logger.Error("Failed To Load Proxy Groups: " + err.Error())
+			return ExitFailure
+		}
+
+		for _, g := range groups {
+			fmt.Printf("%s\t%d proxies\n", g.Name, len(g.Proxies))
+		}
+		return ExitOK
+
+	case "add":
+		fs := newFlagSet("proxies add")
+		group := fs.String("group", "", "name of the new proxy group")
+		file := fs.String("file", "", "text file with one host:port:user:pass per line")
+		if err := fs.Parse(args[1:]); err != nil {
+			return ExitUsage
+		}
+		if *group == "" || *file == "" {
+			return usageError("proxies add requires --group and --file")
+		}
+
+		if err := proxies.ImportProxyGroup(logger, *group, *file); err != nil {
+			logger.Error("Failed To Add Proxy Group: " + err.Error())
+			return ExitFailure
+		}
+
+		logger.Silly("Successfully Saved Proxy Group ✅")
+		return ExitOK
+
+	case "test":
+		fs := newFlagSet("proxies test")
+		group := fs.String("group", "", "proxy group to test")
+		if err := fs.Parse(args[1:]); err != nil {
+			return ExitUsage
+		}
+		if *group == "" {
+			return usageError("proxies test requires --group")
+		}
+
+		groups, err := backend.LoadProxyGroups()
+		if err != nil {
+			logger.Error("Failed To Load Proxy Groups: " + err.Error())
+			return ExitFailure
+		}
+
+		for _, g := range groups {
+			if g.Name == *group {
+				if failed := proxies.TestProxyGroup(logger, g); failed > 0 {
+					logger.Warn(fmt.Sprintf("%d Of %d Proxies Failed", failed, len(g.Proxies)))
+					return ExitFailure
+				}
+				return ExitOK
+			}
+		}
+
+		logger.Error(fmt.Sprintf("Proxy Group Not Found: %s", *group))
+		return ExitFailure
+
+	case "view", "rename", "duplicate", "merge", "delete":
+		return groupCommand(logger, "proxies", "Proxy", backend.ProxyStore, args)
+
+	default:
+		return usageError(fmt.Sprintf("unknown proxies subcommand %q", args[0]))
+	}
+}
+
+// -------------- ACCOUNT COMMANDS -------------- \\
+func accountsCommand(logger *helpers.ColorizedLogger, args []string) int {
+	if len(args) == 0 {
+		return usageError("missing accounts subcommand")
+	}
+
+	switch args[0] {
+	case "list":
+		groups, err := backend.LoadAccountGroups()
+		if err != nil {
+			logger.Error("Failed To Load Account Groups: " + err.Error())
+			return ExitFailure
+		}
+
+		for _, g := range groups {
+			fmt.Printf("%s\t%d accounts\n", g.Name, len(g.Accounts))
+		}
+		return ExitOK
+
+	case "add":
+		fs := newFlagSet("accounts add")
+		group := fs.String("group", "", "name of the new account group")
+		file := fs.String("file", "", "text file with one email:password per line")
+		if err := fs.Parse(args[1:]); err != nil {
+			return ExitUsage
+		}
+		if *group == "" || *file == "" {
+			return usageError("accounts add requires --group and --file")
+		}
+
+		if err := accounts.ImportAccountGroup(logger, *group, *file); err != nil {
+			logger.Error("Failed To Add Account Group: " + err.Error())
+			return ExitFailure
+		}
+
+		logger.Silly("Successfully Saved Account Group ✅")
+		return ExitOK
+
+	case "warm":
+		fs := newFlagSet("accounts warm")
+		group := fs.String("group", "", "account group to warm")
+		proxyGroup := fs.String("proxies", "", "proxy group to log in through")
+		if err := fs.Parse(args[1:]); err != nil {
+			return ExitUsage
+		}
+		if *group == "" || *proxyGroup == "" {
+			return usageError("accounts warm requires --group and --proxies")
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		results, err := accounts.WarmSessions(ctx, logger, *group, *proxyGroup)
+		if err != nil {
+			logger.Error("Failed To Warm Sessions: " + err.Error())
+			return ExitFailure
+		}
+
+		if failed := accounts.LogWarmResults(logger, results); failed > 0 {
+			return ExitFailure
+		}
+		return ExitOK
+
+	case "view", "rename", "duplicate", "merge", "delete":
+		return groupCommand(logger, "accounts", "Account", backend.AccountStore, args)
+
+	default:
+		return usageError(fmt.Sprintf("unknown accounts subcommand %q", args[0]))
+	}
+}
+
+// -------------- PROFILE COMMANDS -------------- \\
+func profilesCommand(logger *helpers.ColorizedLogger, args []string) int {
+	if len(args) == 0 {
+		return usageError("missing profiles subcommand")
+	}
+
+	switch args[0] {
+	case "list":
+		manager := profiles.Groups{}
+		names, err := manager.Names()
+		if err != nil {
+			logger.Error("Failed To Load Profile Groups: " + err.Error())
+			return ExitFailure
+		}
+
+		for _, name := range names {
+			lines, err := manager.View(name)
+			if err != nil {
+				logger.Error("Failed To Load Profile Groups: " + err.Error())
+				return ExitFailure
+			}
+			fmt.Printf("%s\t%d profiles\n", name, len(lines))
+		}
+		return ExitOK
+
+	case "view", "rename", "duplicate", "merge", "delete":
+		return groupCommand(logger, "profiles", "Profile", profiles.Groups{}, args)
+
+	default:
+		return usageError(fmt.Sprintf("unknown profiles subcommand %q", args[0]))
+	}
+}
+
+// groupCommand runs the view, rename, duplicate, merge and delete subcommands the proxies, accounts and profiles commands share
+func groupCommand(logger *helpers.ColorizedLogger, command, title string, manager backend.GroupManager, args []string) int {
+	fs := newFlagSet(command + " " + args[0])
+	group := fs.String("group", "", strings.ToLower(title)+" group, comma separated for merge")
+	to := fs.String("to", "", "new group name for rename and duplicate")
+	into := fs.String("into", "", "group to merge into")
+	if err := fs.Parse(args[1:]); err != nil {
+		return ExitUsage
+	}
+	if *group == "" {
+		return usageError(fmt.Sprintf("%s %s requires --group", command, args[0]))
+	}
+
+	var err error
+	switch args[0] {
+	case "view":
+		lines, err := manager.View(*group)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Failed To View %s Group: %v", title, err))
+			return ExitFailure
+		}
+
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+		return ExitOK
+
+	case "rename", "duplicate":
+		if *to == "" {
+			return usageError(fmt.Sprintf("%s %s requires --group and --to", command, args[0]))
+		}
+		if args[0] == "rename" {
+			err = manager.Rename(*group, *to)
+		} else {
+			err = manager.Duplicate(*group, *to)
+		}
+
+	case "merge":
+		if *into == "" {
+			return usageError(fmt.Sprintf("%s merge requires --into and --group", command))
+		}
+		var sources []string
+		for _, source := range strings.Split(*group, ",") {
+			if source = strings.TrimSpace(source); source != "" {
+				sources = append(sources, source)
+			}
+		}
+		if len(sources) == 0 {
+			return usageError(fmt.Sprintf("%s merge requires --into and --group", command))
+		}
+		err = manager.Merge(*into, sources)
+
+	case "delete":
+		err = manager.Delete(*group)
+	}
+
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed To Update %s Groups: %v", title, err))
+		return ExitFailure
+	}
+	logger.Silly(fmt.Sprintf("Successfully Saved %s Groups ✅", title))
+
+	if args[0] != "duplicate" {
+		issues, err := tasks.ReferenceIssues()
+		if err != nil {
+			logger.Error("Failed To Check Tasks: " + err.Error())
+			return ExitFailure
+		}
+
+		for _, issue := range issues {
+			logger.Warn(issue.String())
+		}
+	}
+	return ExitOK
+}
+
+// -------------- SETTINGS COMMANDS -------------- \\
+func settingsCommand(logger *helpers.ColorizedLogger, args []string) int {
+	if len(args) == 0 {
+		return usageError("missing settings subcommand")
+	}
+
+	switch args[0] {
+	case "set":
+		if len(args) < 2 {
+			return usageError("settings set requires a key")
+		}
+
+		switch strings.ToLower(args[1]) {
+		case "webhook":
+			if len(args) != 3 {
+				return usageError("usage: settings set webhook <url>")
+			}
+
+			if err := setting.UpdateWebhookURL(logger, args[2]); err != nil {
+				logger.Error("Failed To Update Discord Webhook: " + err.Error())
+				return ExitFailure
+			}
+			logger.Silly("Successfully Saved Discord Webhook Settings")
+			return ExitOK
+
+		case "imap":
+			if len(args) != 3 && len(args) != 4 {
+				return usageError("usage: settings set imap <email>")
+			}
+
+			password, err := imapPassword(logger, args[3:])
+			if err != nil {
+				return usageError(err.Error())
+			}
+
+			if err := setting.AddImap(logger, args[2], password); err != nil {
+				logger.Error("Failed To Save IMAP Settings: " + err.Error())
+				return ExitFailure
+			}
+			logger.Silly("Successfully Saved IMAP Settings")
+			return ExitOK
+
+		case "keywords":
+			if len(args) != 3 || (args[2] != "on" && args[2] != "off") {
+				return usageError("usage: settings set keywords on|off")
+			}
+
+			if err := setting.SetKeywords(logger, args[2] == "on"); err != nil {
+				logger.Error("Failed To Save Keyword Settings: " + err.Error())
+				return ExitFailure
+			}
+			logger.Silly("Successfully Saved Keyword Settings")
+			return ExitOK
+
+		default:
+			return usageError(fmt.Sprintf("unknown settings key %q", args[1]))
+		}
+
+	case "test-webhook":
+		settings, err := backend.LoadSettings()
+		if err != nil {
+			logger.Error("Failed To Load Settings: " + err.Error())
+			return ExitFailure
+		}
+
+		if settings.WebhookUrl == "" {
+			logger.Warn("No Webhook URL Was Found In Settings File")
+			return ExitFailure
+		}
+
+		setting.SendTestWebhook(settings)
+		logger.Silly("Webhook Test Successfully Sent ✅")
+		return ExitOK
+
+	default:
+		return usageError(fmt.Sprintf("unknown settings subcommand %q", args[0]))
+	}
+}
+
+// -------------- VAULT COMMANDS -------------- \\
+func vaultCommand(logger *helpers.ColorizedLogger, args []string) int {
+	if len(args) == 0 {
+		return usageError("missing vault subcommand")
+	}
+
+	switch args[0] {
+	case "encrypt":
+		encrypted, err := storage.Encrypted()
+		if err != nil {
+			logger.Error("Failed To Open Storage: " + err.Error())
+			return ExitFailure
+		}
+
+		passphrase := os.Getenv(storage.EnvPassphrase)
+		if !encrypted && len(passphrase) < 8 {
+			return usageError(fmt.Sprintf("vault encrypt needs a passphrase of at least 8 characters in %s", storage.EnvPassphrase))
+		}
+
+		if err := setting.EncryptVault(logger, passphrase); err != nil {
+			return ExitFailure
+		}
+		return ExitOK
+
+	default:
+		return usageError(fmt.Sprintf("unknown vault subcommand %q", args[0]))
+	}
+}
+
+// imapPassword takes the IMAP password from POPMART_IMAP_PASSWORD or a line piped in on stdin, headless mode never prompts
+// so a terminal on stdin is an error. A password still given as an argument works but is warned about
+func imapPassword(logger *helpers.ColorizedLogger, args []string) (string, error) {
+	if len(args) > 0 {
+		logger.Warn("IMAP Password Given As An Argument Is Kept In Shell History, Use " + EnvImapPassword + " Or stdin Instead")
+		return args[0], nil
+	}
+
+	if password := os.Getenv(EnvImapPassword); password != "" {
+		return password, nil
+	}
+
+	info, err := os.Stdin.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice != 0 {
+		return "", fmt.Errorf("settings set imap reads the password from %s or stdin", EnvImapPassword)
+	}
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	password := strings.TrimRight(line, "\r\n")
+	if password == "" {
+		if err == nil || errors.Is(err, io.EOF) {
+			err = fmt.Errorf("no password on stdin")
+		}
+		return "", fmt.Errorf("failed to read the IMAP password from stdin: %w", err)
+	}
+	return password, nil
+}
+
+// -------------- WORKSPACE COMMANDS -------------- \\
+func workspacesCommand(args []string) int {
+	if len(args) == 0 {
+		return usageError("missing workspaces subcommand")
+	}
+
+	switch args[0] {
+	case "list":
+		names, err := storage.Workspaces()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "popmart: failed to list workspaces: %v\n", err)
+			return ExitFailure
+		}
+
+		for _, name := range names {
+			marker := " "
+			if name == storage.Workspace() {
+				marker = "*"
+			}
+			fmt.Printf("%s %s\n", marker, name)
+		}
+		return ExitOK
+
+	default:
+		return usageError(fmt.Sprintf("unknown workspaces subcommand %q", args[0]))
+	}
+}
+
+// -------------- UTILITY FUNCTIONS -------------- \\
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	return fs
+}
+
+func usageError(message string) int {
+	fmt.Fprintf(os.Stderr, "popmart: %s\n\n%s", message, usage)
+	return ExitUsage
+}
@@ -0,0 +1,313 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	tasks "popmart/src/backend/tasks"
+	helpers "popmart/src/middleware/helpers"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	refreshInterval = 250 * time.Millisecond
+	maxLogLines     = 1000
+)
+
+var (
+	headerStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#5665DA"))
+	columnStyle   = lipgloss.NewStyle().Bold(true).Underline(true)
+	selectedStyle = lipgloss.NewStyle().Reverse(true)
+	helpStyle     = lipgloss.NewStyle().Faint(true)
+	stepColors    = map[helpers.TaskStep]lipgloss.Style{
+		helpers.StepDone:    lipgloss.NewStyle().Foreground(lipgloss.Color("2")),
+		helpers.StepFailed:  lipgloss.NewStyle().Foreground(lipgloss.Color("1")),
+		helpers.StepStopped: lipgloss.NewStyle().Foreground(lipgloss.Color("3")),
+	}
+)
+
+// Run starts the task group behind a full-screen view of every task and the log output, returning once the user leaves it
+func Run(logger *helpers.ColorizedLogger, group string, loadedTasks []helpers.Task, opts tasks.RunOptions) (tasks.RunSummary, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logs := &logBuffer{}
+	logger.SetOutput(logs)
+	defer logger.SetOutput(nil)
+
+	restore := helpers.OnInterrupt(cancel)
+	defer restore()
+
+	m := &model{
+		group:  group,
+		cancel: cancel,
+		logs:   logs,
+		start:  time.Now(),
+	}
+	program := tea.NewProgram(m, tea.WithAltScreen())
+
+	var summary tasks.RunSummary
+	done := make(chan struct{})
+	go func() {
+		summary = tasks.RunTasks(ctx, logger, loadedTasks, opts)
+		close(done)
+		program.Send(doneMsg{})
+	}()
+
+	_, err := program.Run()
+	if err != nil {
+		cancel()
+	}
+
+	// The view only closes early on a terminal error, so wait for the workers to wind down either way
+	<-done
+	return summary, err
+}
+
+// --------------- LOG BUFFER --------------- \\
+type logBuffer struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, line := range strings.Split(strings.TrimRight(string(p), "\n"), "\n") {
+		b.lines = append(b.lines, line)
+	}
+	if len(b.lines) > maxLogLines {
+		b.lines = b.lines[len(b.lines)-maxLogLines:]
+	}
+	return len(p), nil
+}
+
+func (b *logBuffer) tail(n int) []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if n > len(b.lines) {
+		n = len(b.lines)
+	}
+	return append([]string(nil), b.lines[len(b.lines)-n:]...)
+}
+
+// --------------- MODEL --------------- \\
+type tickMsg time.Time
+
+type doneMsg struct{}
+
+type model struct {
+	group    string
+	cancel   context.CancelFunc
+	logs     *logBuffer
+	start    time.Time
+	statuses []helpers.TaskStatus
+	selected int
+	offset   int
+	width    int
+	height   int
+	stopping bool
+	done     bool
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(refreshInterval, func(t time.Time) tea.Msg { return tickMsg(t) })
+}
+
+func (m *model) Init() tea.Cmd {
+	m.statuses = helpers.TaskStatuses()
+	return tick()
+}
+
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+
+	case tickMsg:
+		m.statuses = helpers.TaskStatuses()
+		if m.selected >= len(m.statuses) {
+			m.selected = max(len(m.statuses)-1, 0)
+		}
+		return m, tick()
+
+	case doneMsg:
+		m.done = true
+		m.statuses = helpers.TaskStatuses()
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			if m.selected > 0 {
+				m.selected--
+			}
+		case "down", "j":
+			if m.selected < len(m.statuses)-1 {
+				m.selected++
+			}
+		case "pgup":
+			m.selected = max(m.selected-m.tableHeight(), 0)
+		case "pgdown":
+			m.selected = min(m.selected+m.tableHeight(), max(len(m.statuses)-1, 0))
+		case "s":
+			if !m.done && m.selected < len(m.statuses) {
+				helpers.StopTask(m.statuses[m.selected].TaskId)
+			}
+		case "x", "ctrl+c":
+			if !m.done {
+				m.stopping = true
+				m.cancel()
+			} else {
+				return m, tea.Quit
+			}
+		case "q", "esc", "enter":
+			if m.done {
+				return m, tea.Quit
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m *model) tableHeight() int {
+	// Header, totals, column titles, log divider and help line take five rows, the rest is split with the log pane
+	available := m.height - 5
+	return max(available*3/5, 3)
+}
+
+func (m *model) View() string {
+	if m.width == 0 {
+		return "Loading Dashboard..."
+	}
+
+	var b strings.Builder
+	counts := map[helpers.TaskStep]int{}
+	retries := 0
+	for _, s := range m.statuses {
+		counts[s.Step]++
+		retries += totalRetries(s)
+	}
+	carted, secured := helpers.Counters()
+	running := len(m.statuses) - counts[helpers.StepQueued] - counts[helpers.StepDone] - counts[helpers.StepFailed] - counts[helpers.StepStopped]
+
+	state := "Running"
+	switch {
+	case m.done:
+		state = "Finished"
+	case m.stopping:
+		state = "Stopping"
+	}
+
+	b.WriteString(headerStyle.Render(fmt.Sprintf("Popmart CLI | %s | %s | %s", m.group, state, time.Since(m.start).Truncate(time.Second))))
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("Tasks: %d | Running: %d | Carted: %d | Secured: %d | Failed: %d | Stopped: %d | Retries: %d\n",
+		len(m.statuses), running, carted, secured, counts[helpers.StepFailed], counts[helpers.StepStopped], retries))
+
+	taskWidth, accountWidth, profileWidth, stepWidth, retryWidth := 8, 28, 16, 13, 7
+	statusWidth := max(m.width-taskWidth-accountWidth-profileWidth-stepWidth-retryWidth-5, 10)
+	row := func(cols ...string) string {
+		widths := []int{taskWidth, accountWidth, profileWidth, stepWidth, retryWidth, statusWidth}
+		cells := make([]string, len(cols))
+		for i, col := range cols {
+			cells[i] = fit(col, widths[i])
+		}
+		return strings.Join(cells, " ")
+	}
+	b.WriteString(columnStyle.Render(row("Task", "Account", "Profile", "Step", "Retries", "Last Status")))
+	b.WriteString("\n")
+
+	height := m.tableHeight()
+	if m.selected < m.offset {
+		m.offset = m.selected
+	}
+	if m.selected >= m.offset+height {
+		m.offset = m.selected - height + 1
+	}
+	for i := m.offset; i < m.offset+height; i++ {
+		if i >= len(m.statuses) {
+			b.WriteString("\n")
+			continue
+		}
+
+		s := m.statuses[i]
+		line := row(shortId(s.TaskId), s.Account, s.Profile, string(s.Step), fmt.Sprintf("%d", totalRetries(s)), lastStatus(s))
+		switch {
+		case i == m.selected:
+			line = selectedStyle.Render(line)
+		default:
+			if style, ok := stepColors[s.Step]; ok {
+				line = style.Render(line)
+			}
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	b.WriteString(helpStyle.Render(strings.Repeat("─", m.width)))
+	b.WriteString("\n")
+
+	logHeight := max(m.height-height-5, 1)
+	lines := m.logs.tail(logHeight)
+	for i := 0; i < logHeight; i++ {
+		if i < len(lines) {
+			b.WriteString(lipgloss.NewStyle().MaxWidth(m.width).Render(lines[i]))
+		}
+		b.WriteString("\n")
+	}
+
+	help := "↑/↓ Select | s Stop Task | x Stop Group"
+	if m.done {
+		help = "Task Group Finished | q Return To Menu"
+	}
+	b.WriteString(helpStyle.Render(help))
+	return b.String()
+}
+
+// --------------- UTILITY FUNCTIONS --------------- \\
+func totalRetries(s helpers.TaskStatus) int {
+	retries := 0
+	for _, attempts := range s.Attempts {
+		retries += attempts - 1
+	}
+	return retries
+}
+
+func lastStatus(s helpers.TaskStatus) string {
+	switch {
+	case s.Step == helpers.StepDone && s.Sku != "":
+		return fmt.Sprintf("Checked Out %s [%s]", s.Product, s.Sku)
+	case s.LastError != "":
+		return s.LastError
+	case s.Step == helpers.StepQueued:
+		return "Waiting For Worker"
+	case !s.FinishedAt.IsZero():
+		return fmt.Sprintf("Finished In %s", s.FinishedAt.Sub(s.StartedAt).Truncate(time.Second))
+	default:
+		return fmt.Sprintf("In Step For %s", time.Since(s.StepStartedAt).Truncate(time.Second))
+	}
+}
+
+func shortId(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
+
+func fit(value string, width int) string {
+	runes := []rune(value)
+	if len(runes) > width {
+		if width <= 1 {
+			return string(runes[:width])
+		}
+		return string(runes[:width-1]) + "…"
+	}
+	return value + strings.Repeat(" ", width-len(runes))
+}
@@ -0,0 +1,164 @@
+package groups
+
+import (
+	"fmt"
+
+	backend "popmart/src/backend"
+	tasks "popmart/src/backend/tasks"
+	helpers "popmart/src/middleware/helpers"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// ManageGroups lets the user view, rename, duplicate, merge and delete the groups of one kind, title is "Proxy", "Account" or "Profile"
+func ManageGroups(logger *helpers.ColorizedLogger, title string, manager backend.GroupManager) {
+	for {
+		names, err := manager.Names()
+		if err != nil {
+			logger.Error(fmt.Sprintf("Failed To Load %s Groups: %v", title, err))
+			return
+		}
+
+		if len(names) == 0 {
+			logger.Warn(fmt.Sprintf("No %s Groups Found", title))
+			return
+		}
+
+		// Picked by position so a group called "Back" still works
+		var choice int
+		groupPrompt := &survey.Select{
+			Message: fmt.Sprintf("Select %s Group:", title),
+			Options: append(names, "Back"),
+		}
+		if err := survey.AskOne(groupPrompt, &choice); err != nil {
+			logger.Error("Prompt Cancelled Or Failed: " + err.Error())
+			return
+		}
+		if choice == len(names) {
+			return
+		}
+		groupName := names[choice]
+
+		var result string
+		options := []string{
+			"View",
+			"Rename",
+			"Duplicate",
+			"Merge Into",
+			"Delete",
+			"Back",
+		}
+
+		prompt := &survey.Select{
+			Message: fmt.Sprintf("%s Group %s:", title, groupName),
+			Options: options,
+		}
+		if err := survey.AskOne(prompt, &result); err != nil {
+			logger.Error("Prompt Cancelled Or Failed: " + err.Error())
+			continue
+		}
+
+		switch result {
+		case "View":
+			lines, err := manager.View(groupName)
+			if err != nil {
+				logger.Error(fmt.Sprintf("Failed To View %s Group: %v", title, err))
+				continue
+			}
+
+			logger.Info(fmt.Sprintf("%s Group %s Has %d Entries", title, groupName, len(lines)))
+			for i, line := range lines {
+				fmt.Printf("  %d. %s\n", i+1, line)
+			}
+
+		case "Rename", "Duplicate":
+			var newName string
+			namePrompt := &survey.Input{
+				Message: fmt.Sprintf("Enter New %s Group Name:", title),
+			}
+			if err := survey.AskOne(namePrompt, &newName, survey.WithValidator(survey.Required)); err != nil {
+				logger.Error("Prompt Has Failed Or Been Cancelled: " + err.Error())
+				continue
+			}
+
+			if result == "Rename" {
+				err = manager.Rename(groupName, newName)
+			} else {
+				err = manager.Duplicate(groupName, newName)
+			}
+			if err != nil {
+				logger.Error(fmt.Sprintf("Failed To %s %s Group: %v", result, title, err))
+				continue
+			}
+
+			logger.Silly(fmt.Sprintf("Successfully Saved %s Group %s ✅", title, newName))
+			if result == "Rename" {
+				CheckReferences(logger)
+			}
+
+		case "Merge Into":
+			var sources []string
+			var others []string
+			for _, name := range names {
+				if name != groupName {
+					others = append(others, name)
+				}
+			}
+
+			if len(others) == 0 {
+				logger.Warn(fmt.Sprintf("No Other %s Groups To Merge", title))
+				continue
+			}
+
+			sourcePrompt := &survey.MultiSelect{
+				Message: fmt.Sprintf("Select Groups To Merge Into %s:", groupName),
+				Options: others,
+			}
+			if err := survey.AskOne(sourcePrompt, &sources, survey.WithValidator(survey.Required)); err != nil {
+				logger.Error("Prompt Has Failed Or Been Cancelled: " + err.Error())
+				continue
+			}
+
+			if err := manager.Merge(groupName, sources); err != nil {
+				logger.Error(fmt.Sprintf("Failed To Merge %s Groups: %v", title, err))
+				continue
+			}
+
+			logger.Silly(fmt.Sprintf("Successfully Merged %d %s Groups Into %s ✅", len(sources), title, groupName))
+			CheckReferences(logger)
+
+		case "Delete":
+			confirm := false
+			if err := survey.AskOne(&survey.Confirm{Message: fmt.Sprintf("Delete %s Group %s?", title, groupName)}, &confirm); err != nil || !confirm {
+				continue
+			}
+
+			if err := manager.Delete(groupName); err != nil {
+				logger.Error(fmt.Sprintf("Failed To Delete %s Group: %v", title, err))
+				continue
+			}
+
+			logger.Silly(fmt.Sprintf("Successfully Deleted %s Group %s ✅", title, groupName))
+			CheckReferences(logger)
+
+		case "Back":
+			continue
+
+		default:
+			logger.Warn("Invalid option selected")
+		}
+	}
+}
+
+// CheckReferences warns about tasks.csv rows left pointing at a group that was renamed, merged away or deleted
+func CheckReferences(logger *helpers.ColorizedLogger) {
+	issues, err := tasks.ReferenceIssues()
+	if err != nil {
+		logger.Error("Failed To Check Tasks: " + err.Error())
+		return
+	}
+
+	for _, issue := range issues {
+		logger.Warn(issue.String())
+	}
+}
@@ -1,47 +1,205 @@
-package profiles
-
-import (
-	"fmt"
-
-	profiles "popmart/src/backend/profiles"
-	helpers "popmart/src/middleware/helpers"
-
-	"github.com/AlecAivazis/survey/v2"
-)
-
-func ProfilesMenu(logger *helpers.ColorizedLogger) {
-	for {
-		var result string
-		options := []string{
-			"Open Profiles",
-			"Back",
-		}
-
-		prompt := &survey.Select{
-			Message: "Profiles Menu:",
-			Options: options,
-		}
-
-		err := survey.AskOne(prompt, &result)
-		if err != nil {
-			logger.Error(fmt.Sprintf("Failed To Prompt Profiles Menu: %v", err))
-			return
-		}
-
-		switch result {
-		case "Open Profiles":
-			err := profiles.OpenProfilesCSV()
-			if err != nil {
-				logger.Error("Failed To Open Profiles: " + err.Error())
-				continue
-			}
-			logger.Silly("Opened Profiles CSV In Default Editor")
-
-		case "Back":
-			return
-
-		default:
-			logger.Warn("Invalid option selected")
-		}
-	}
-}
+package profiles
+
+import (
+	"fmt"
+
+	profiles "popmart/src/backend/profiles"
+	tasks "popmart/src/backend/tasks"
+	groups "popmart/src/frontend/groups"
+	helpers "popmart/src/middleware/helpers"
+	storage "popmart/src/middleware/helpers/storage"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+func ProfilesMenu(logger *helpers.ColorizedLogger) {
+	for {
+		var result string
+		options := []string{
+			"Open Profiles",
+			"Edit Profiles",
+			"Manage Profile Groups",
+			"Back",
+		}
+
+		prompt := &survey.Select{
+			Message: "Profiles Menu:",
+			Options: options,
+		}
+
+		err := survey.AskOne(prompt, &result)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Failed To Prompt Profiles Menu: %v", err))
+			return
+		}
+
+		switch result {
+		case "Open Profiles":
+			err := profiles.OpenProfilesCSV(logger, func() {
+				// Only reached when the document lives in the database and has to be saved back
+				survey.AskOne(&survey.Input{Message: "Save profiles.csv, Then Press Enter:"}, new(string))
+			})
+			if err != nil {
+				logger.Error("Failed To Open Profiles: " + err.Error())
+				continue
+			}
+			logger.Silly("Opened Profiles CSV In Default Editor")
+
+		case "Edit Profiles":
+			editProfiles(logger)
+
+		case "Manage Profile Groups":
+			groups.ManageGroups(logger, "Profile", profiles.Groups{})
+
+		case "Back":
+			return
+
+		default:
+			logger.Warn("Invalid option selected")
+		}
+	}
+}
+
+// editProfiles walks the user from a profile group to one of its profiles, then edits, adds or deletes it
+func editProfiles(logger *helpers.ColorizedLogger) {
+	names, err := profiles.Groups{}.Names()
+	if err != nil {
+		logger.Error("Failed To Load Profile Groups: " + err.Error())
+		return
+	}
+
+	// Picked by position, a real group can be called "New Group" too
+	var choice int
+	groupPrompt := &survey.Select{
+		Message: "Select Profile Group:",
+		Options: append(names, "New Group"),
+	}
+	if err := survey.AskOne(groupPrompt, &choice); err != nil {
+		logger.Error("Prompt Cancelled Or Failed: " + err.Error())
+		return
+	}
+
+	var group string
+	if choice < len(names) {
+		group = names[choice]
+	} else {
+		namePrompt := &survey.Input{
+			Message: "Enter Profile Group Name:",
+		}
+		if err := survey.AskOne(namePrompt, &group, survey.WithValidator(survey.Required)); err != nil {
+			logger.Error("Prompt Has Failed Or Been Cancelled: " + err.Error())
+			return
+		}
+	}
+
+	for {
+		groupProfiles, err := profiles.Profiles(group)
+		if err != nil {
+			logger.Error("Failed To Load Profiles: " + err.Error())
+			return
+		}
+
+		var options []string
+		for i, values := range groupProfiles {
+			options = append(options, fmt.Sprintf("%d. %s (%s)", i+1, values["Profile Name"], values["Email"]))
+		}
+		options = append(options, "New Profile", "Back")
+
+		var position int
+		profilePrompt := &survey.Select{
+			Message: fmt.Sprintf("Profile Group %s:", group),
+			Options: options,
+		}
+		if err := survey.AskOne(profilePrompt, &position); err != nil {
+			logger.Error("Prompt Cancelled Or Failed: " + err.Error())
+			return
+		}
+
+		switch {
+		case position == len(groupProfiles):
+			saveProfile(logger, group, -1, map[string]string{})
+
+		case position > len(groupProfiles):
+			return
+
+		default:
+			var action string
+			actionPrompt := &survey.Select{
+				Message: options[position] + ":",
+				Options: []string{"Edit", "Delete", "Back"},
+			}
+			if err := survey.AskOne(actionPrompt, &action); err != nil {
+				logger.Error("Prompt Cancelled Or Failed: " + err.Error())
+				continue
+			}
+
+			switch action {
+			case "Edit":
+				saveProfile(logger, group, position, groupProfiles[position])
+
+			case "Delete":
+				confirm := false
+				if err := survey.AskOne(&survey.Confirm{Message: "Delete " + options[position] + "?"}, &confirm); err != nil || !confirm {
+					continue
+				}
+
+				if err := profiles.DeleteProfile(group, position); err != nil {
+					logger.Error("Failed To Delete Profile: " + err.Error())
+					continue
+				}
+				logger.Silly("Successfully Deleted Profile ✅")
+			}
+		}
+	}
+}
+
+// saveProfile prompts for every profile column with the current values as defaults, saves the profile and checks its row
+func saveProfile(logger *helpers.ColorizedLogger, group string, position int, values map[string]string) {
+	for _, column := range helpers.ProfileColumns {
+		name := column.Names[0]
+		if name == helpers.ProfileGroupColumn {
+			continue
+		}
+
+		input := &survey.Input{
+			Message: name + ":",
+			Default: values[name],
+		}
+		if name == "Payment Method" {
+			input.Help = "Card or Paypal, leave blank to use the task's payment method"
+		}
+
+		var opts []survey.AskOpt
+		if column.Required {
+			opts = append(opts, survey.WithValidator(survey.Required))
+		}
+
+		var value string
+		if err := survey.AskOne(input, &value, opts...); err != nil {
+			logger.Error("Prompt Has Failed Or Been Cancelled: " + err.Error())
+			return
+		}
+		values[name] = value
+	}
+
+	row, err := profiles.SaveProfile(group, position, values)
+	if err != nil {
+		logger.Error("Failed To Save Profile: " + err.Error())
+		return
+	}
+	logger.Silly(fmt.Sprintf("Successfully Saved Profile %s ✅", values["Profile Name"]))
+
+	issues, err := tasks.Validate("")
+	if err != nil {
+		logger.Error("Failed To Validate Profile: " + err.Error())
+		return
+	}
+
+	var rowIssues []tasks.Issue
+	for _, issue := range issues {
+		if issue.File == storage.ProfilesFile && issue.Row == row {
+			rowIssues = append(rowIssues, issue)
+		}
+	}
+	tasks.LogIssues(logger, rowIssues)
+}
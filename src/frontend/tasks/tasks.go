@@ -1,110 +1,148 @@
-package tasks
-
-import (
-	"fmt"
-	"runtime"
-	"sync"
-
-	tasks "popmart/src/backend/tasks"
-	helpers "popmart/src/middleware/helpers"
-	desktop "popmart/src/middleware/modules/desktop"
-
-	"github.com/AlecAivazis/survey/v2"
-)
-
-func TasksMenu(logger *helpers.ColorizedLogger) {
-	for {
-		var result string
-		options := []string{
-			"Start Tasks",
-			"Open Tasks",
-			"Back",
-		}
-
-		mainPrompt := &survey.Select{
-			Message: "Tasks Menu:",
-			Options: options,
-		}
-
-		err := survey.AskOne(mainPrompt, &result)
-		if err != nil {
-			logger.Error(fmt.Sprintf("Failed To Prompt Tasks Menu: %v", err))
-			return
-		}
-
-		switch result {
-		case "Start Tasks":
-			groups, err := tasks.LoadTaskGroups()
-			if err != nil {
-				logger.Error("Failed To Load Task Groups: " + err.Error())
-				continue
-			}
-
-			if len(groups) == 0 {
-				logger.Warn("No Task Groups Found In tasks.csv")
-				continue
-			}
-
-			var selectedGroup string
-			groupPrompt := &survey.Select{
-				Message: "Select Task Group To Start:",
-				Options: groups,
-			}
-
-			err = survey.AskOne(groupPrompt, &selectedGroup)
-			if err != nil {
-				logger.Error("Prompt Cancelled Or Failed: " + err.Error())
-				continue
-			}
-
-			loadedTasks, err := tasks.LoadTasks(logger, selectedGroup)
-			if err != nil {
-				logger.Error("Failed To Load Tasks: " + err.Error())
-				continue
-			}
-
-			maxWorkers := helpers.CalculateWorkers()
-			logger.Info(fmt.Sprintf("Starting %d Workers Based On %d CPU Cores", maxWorkers, runtime.NumCPU()))
-
-			var wg sync.WaitGroup
-			tasksChan := make(chan helpers.Task)
-
-			for range make([]struct{}, maxWorkers) {
-				wg.Add(1)
-				go func() {
-					defer wg.Done()
-					for t := range tasksChan {
-						switch t.Mode {
-						case "Desktop":
-							desktop.PopmartDesktop(t, logger)
-						case "App":
-							logger.Error("App Mode Is Currently Down For Maintenance")
-						default:
-							logger.Warn(fmt.Sprintf("Task %s: Unsupported Task Mode Has Been Declared", t.TaskId))
-						}
-					}
-				}()
-			}
-
-			for _, task := range loadedTasks {
-				tasksChan <- task
-			}
-			close(tasksChan)
-
-			wg.Wait()
-		case "Open Tasks":
-			err := tasks.OpenTasksCSV()
-			if err != nil {
-				logger.Error("Failed To Open Tasks: " + err.Error())
-				continue
-			}
-			logger.Silly("Opened Tasks CSV In Default Editor")
-
-		case "Back":
-			return
-
-		default:
-			logger.Warn("Invalid option selected")
-		}
-	}
-}
+package tasks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tasks "popmart/src/backend/tasks"
+	dashboard "popmart/src/frontend/dashboard"
+	helpers "popmart/src/middleware/helpers"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+func TasksMenu(logger *helpers.ColorizedLogger) {
+	for {
+		var result string
+		options := []string{
+			"Start Tasks",
+			"Open Tasks",
+			"Validate Tasks",
+			"Back",
+		}
+
+		mainPrompt := &survey.Select{
+			Message: "Tasks Menu:",
+			Options: options,
+		}
+
+		err := survey.AskOne(mainPrompt, &result)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Failed To Prompt Tasks Menu: %v", err))
+			return
+		}
+
+		switch result {
+		case "Start Tasks":
+			groups, err := tasks.LoadTaskGroups()
+			if err != nil {
+				logger.Error("Failed To Load Task Groups: " + err.Error())
+				continue
+			}
+
+			if len(groups) == 0 {
+				logger.Warn("No Task Groups Found In tasks.csv")
+				continue
+			}
+
+			var selectedGroup string
+			groupPrompt := &survey.Select{
+				Message: "Select Task Group To Start:",
+				Options: groups,
+			}
+
+			err = survey.AskOne(groupPrompt, &selectedGroup)
+			if err != nil {
+				logger.Error("Prompt Cancelled Or Failed: " + err.Error())
+				continue
+			}
+
+			loadedTasks, err := tasks.LoadTasks(logger, selectedGroup)
+			if err != nil {
+				logger.Error("Failed To Load Tasks: " + err.Error())
+				continue
+			}
+
+			var timeoutInput string
+			timeoutPrompt := &survey.Input{
+				Message: "Stop Task Group After (e.g. 30m, Blank For Never):",
+			}
+			if err := survey.AskOne(timeoutPrompt, &timeoutInput); err != nil {
+				logger.Error("Prompt Cancelled Or Failed: " + err.Error())
+				continue
+			}
+
+			var opts tasks.RunOptions
+			if timeoutInput = strings.TrimSpace(timeoutInput); timeoutInput != "" {
+				opts.Timeout, err = time.ParseDuration(timeoutInput)
+				if err != nil {
+					logger.Error("Invalid Duration: " + err.Error())
+					continue
+				}
+			}
+
+			var checkoutsInput string
+			checkoutsPrompt := &survey.Input{
+				Message: "Stop After N Successful Checkouts (0 For Never):",
+				Default: "0",
+			}
+			if err := survey.AskOne(checkoutsPrompt, &checkoutsInput); err != nil {
+				logger.Error("Prompt Cancelled Or Failed: " + err.Error())
+				continue
+			}
+			opts.MaxCheckouts = tasks.ParseInt(strings.TrimSpace(checkoutsInput), 0)
+
+			var startInput string
+			startPrompt := &survey.Input{
+				Message: "Start At (e.g. 19:00 Or 0 19 * * 5, Blank For Start Time Column Or Now):",
+			}
+			if err := survey.AskOne(startPrompt, &startInput); err != nil {
+				logger.Error("Prompt Cancelled Or Failed: " + err.Error())
+				continue
+			}
+
+			opts.StartAt, err = tasks.ParseStartTime(startInput, time.Now())
+			if err != nil {
+				logger.Error("Invalid Start Time: " + err.Error())
+				continue
+			}
+
+			summary, err := dashboard.Run(logger, selectedGroup, loadedTasks, opts)
+			if err != nil {
+				logger.Error("Failed To Render Task Dashboard: " + err.Error())
+			}
+			tasks.LogSummary(logger, summary)
+		case "Open Tasks":
+			err := tasks.OpenTasksCSV(logger, func() {
+				// Only reached when the document lives in the database and has to be saved back
+				survey.AskOne(&survey.Input{Message: "Save tasks.csv, Then Press Enter:"}, new(string))
+			})
+			if err != nil {
+				logger.Error("Failed To Open Tasks: " + err.Error())
+				continue
+			}
+			logger.Silly("Opened Tasks CSV In Default Editor")
+
+		case "Validate Tasks":
+			issues, err := tasks.Validate("")
+			if err != nil {
+				logger.Error("Failed To Validate Tasks: " + err.Error())
+				continue
+			}
+
+			errorCount := tasks.LogIssues(logger, issues)
+			if errorCount > 0 {
+				logger.Error(fmt.Sprintf("Found %d Errors And %d Warnings", errorCount, len(issues)-errorCount))
+				continue
+			}
+			logger.Silly(fmt.Sprintf("tasks.csv And profiles.csv Look Good (%d Warnings) ✅", len(issues)))
+
+		case "Back":
+			return
+
+		default:
+			logger.Warn("Invalid option selected")
+		}
+	}
+}
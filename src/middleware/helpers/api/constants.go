@@ -1,312 +1,312 @@
-package api
-
-import (
-	"math/rand"
-	"time"
-)
-
-var rng = rand.New(rand.NewSource(time.Now().UnixNano()))
-var WebGLs = []string{
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3090 (0x00002204) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 620 (0x00003EA0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) HD Graphics 400 Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 630 (0x00003E9B) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R5 340 (0x00006611) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Google)-&-ANGLE (Google, Vulkan 1.3.0 (SwiftShader Device (Subzero) (0x0000C0DE)), SwiftShader driver)||",
-	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 505, OpenGL ES 3.2)||",
-	"Google Inc. (Mali)-&-ANGLE (Mali, Mali-G68, OpenGL ES 3.2)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2070 with Max‑Q Design (0x00001F10) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 618, OpenGL ES 3.2)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel Open Source Technology Center, Mesa DRI Intel(R) HD Graphics 4600 (HSW GT2), OpenGL 4.5)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel Inc., Intel(R) Iris(TM) Plus Graphics 645, OpenGL 4.1)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) HD Graphics Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 618, OpenGL ES 3.2)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics (0x0000A7A1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) HD Graphics 3000 OpenGL Engine)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1660 Ti Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5700 XT Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 750 (0x0000AAAA) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Mesa)-&-ANGLE (Mesa/X11, AMD Radeon RX 580, OpenGL 4.6)||",
-	"Google Inc. (Gallium)-&-ANGLE (llvmpipe, LLVM 12.0, Mesa 20.3.4)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(TM) Xe Max Graphics, Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 Laptop GPU (0x000027E3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 600, OpenGL ES 3.0)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 380 (0x00006001) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 530, OpenGL ES 3.2)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 96 EUs (0x00006A2E) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN RTX (0x00002373) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Vega 8 Graphics, OpenGL 4.6)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 605, OpenGL ES 3.0)||",
-	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 650, OpenGL ES 3.2)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics G7 80EUs (0x00006A2F) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3070 Desktop GPU (0x00002474) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5600 XT Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 500 (0x0000A065) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 660, OpenGL ES 3.2)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 128 EUs (0x00006A20) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2060 Super (0x00002404) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Vega 10 Graphics, OpenGL 4.6)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 510 (0x0000A066) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 512, OpenGL ES 3.2)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 96 EUs 0x00006A21 Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2080 SUPER (0x000022AE) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600 XT Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 601, OpenGL ES 3.0)||",
-	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 540, OpenGL ES 3.2)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics DG1 (0x0000A782) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1080 Ti (0x00001B06) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6800 Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 602, OpenGL ES 3.0)||",
-	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 618M, OpenGL ES 3.2)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics P-series (0x00006A23) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3050 Laptop GPU (0x000025E6) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 570 Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 700 (0x0000A07B) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 530M, OpenGL ES 3.2)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 96 EUs 0x00006A22 Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3090 Ti (0x000024CD) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6700 XT Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 710 (0x0000A07C) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 680, OpenGL ES 3.2)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 128 EUs P-series (0x00006A24) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4060 (0x00005277) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7600 Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 712 (0x0000A07D) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 700, OpenGL ES 3.2)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 96 EUs Q-series (0x00006A25) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4070 Ti (0x000054C6) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XT Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 714 (0x0000A07E) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 710, OpenGL ES 3.2)||",
-	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 128 EUs R-series (0x00006A26) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 Super (0x000055A8) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1650 (0x00001F82) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1650 Super (0x00001F91) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1660 (0x000021C0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN Xp (0x00001B02) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN RTX (0x00001E02) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3050 (0x00002589) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3050 Ti Laptop GPU (0x000025A0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3060 Laptop GPU (0x000025A2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 (0x0000210A) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 Ti (0x0000210F) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6400 (0x000073C6) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6500 XT (0x000073C7) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600 XT (0x000073C8) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6700 (0x000073C9) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6750 XT (0x000073CA) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6800 XT (0x000073CB) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6850 XT (0x000073CC) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6950 XT (0x000073CD) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7600 (0x000073CE) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7700 XT (0x000073D0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4060 (0x00002588) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4060 Ti (0x0000258A) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4070 (0x0000258B) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4070 Super (0x0000258C) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 (0x0000258D) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 Super (0x0000258E) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4090 (0x0000258F) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A2000 (0x00002590) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A4000 (0x00002591) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A5000 (0x00002592) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7800 XT (0x000073D1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XT (0x000073D2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XTX (0x000073D3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7950 XT (0x000073D4) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W6600 (0x000073D5) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W6800 (0x000073D6) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W7900 (0x000073D7) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro VII (0x000073D8) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7700 (0x000073D9) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 GRE (0x000073DA) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A6000 (0x00002593) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN Z (0x00001783) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro P4000 (0x00001BB1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro P5000 (0x00001BB0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro P6000 (0x00001BB2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX150 (0x00001D10) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX250 (0x00001D13) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX330 (0x00001D16) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX450 (0x00001D52) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A1000 (0x00002595) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 970 (0x000013C2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 980 (0x000013D0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 980 Ti (0x000017C8) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1080 (0x00001B80) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1080 Ti (0x00001B06) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2080 (0x00001E87) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2080 Ti (0x00001E05) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3060 (0x00002560) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3060 Ti (0x00002520) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3070 (0x00002440) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3070 Ti (0x00002509) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 (0x0000210A) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 Ti (0x0000210F) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3090 (0x00002404) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4090 (0x00002680) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN V (0x00001DB4) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN RTX (0x00001E02) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 480 (0x000067DF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 570 (0x000067DF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 580 (0x000067DF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 590 (0x000067DF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX Vega 56 (0x000068C7) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX Vega 64 (0x000068C8) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon VII (0x00008002) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5600 XT (0x000073FF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5700 (0x000073BF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5700 XT (0x000073BF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600 (0x000073EF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600 XT (0x000073C8) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6700 XT (0x000073DF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6750 XT (0x000073CA) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6800 (0x000073BF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6800 XT (0x000073CB) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6900 XT (0x000073AF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6950 XT (0x000073CD) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7600 XT (0x000073CE) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7700 XT (0x000073D0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7800 XT (0x000073D1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XT (0x000073D2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XTX (0x000073D3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A2000 (0x00002590) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A4000 (0x00002591) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A5000 (0x00002592) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A6000 (0x00002593) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro RTX 3000 (0x00001F04) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro RTX 4000 (0x00001EB1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro RTX 5000 (0x00001EB0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro RTX 6000 (0x00001EB2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro RTX 8000 (0x00001EB3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3050 (0x00002561) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3050 Ti (0x00002562) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4060 (0x00002563) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4060 Ti (0x00002564) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4070 (0x00002565) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4070 Ti (0x00002566) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 (0x00002567) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 Ti (0x00002568) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4090 (0x00002569) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6700 (0x000073CA) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6750 XT (0x000073CB) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6900 XT (0x000073CC) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7600 (0x000073CD) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7700 (0x000073CE) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7800 XT (0x000073CF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XT (0x000073D0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W6600 (0x000073D1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W6800 (0x000073D2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 760 (0x000010FD) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 770 (0x000010B1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 780 (0x000010B3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 780 Ti (0x000010B4) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 960 (0x000014C2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 970 (0x000014C3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 980 (0x000014E0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 980 Ti (0x000014F0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1050 (0x00001C81) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1050 Ti (0x00001C82) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1060 (0x00001C03) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1070 (0x00001B81) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1070 Ti (0x00001B82) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1080 (0x00001B80) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1080 Ti (0x00001B06) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX Titan X (0x00001B00) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2060 (0x00001E84) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2060 Super (0x00001F06) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2070 (0x00001F02) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2070 Super (0x000021C9) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2080 (0x00001E87) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2080 Super (0x000021C0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2080 Ti (0x00001E02) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3060 (0x00002560) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3060 Ti (0x00002520) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3070 (0x00002440) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3070 Ti (0x000025A1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 (0x0000210A) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 Ti (0x0000210F) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3090 (0x00002404) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3090 Ti (0x000024CD) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 (0x00002589) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 Ti (0x0000258D) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4090 (0x00002680) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Titan RTX (0x00001E02) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN V (0x00001DB4) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon HD 7770 (0x00007122) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon HD 7850 (0x00007121) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon HD 7870 (0x00007120) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 270 (0x000067C0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 270X (0x000067C1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 280 (0x000067C2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 280X (0x000067C3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 290 (0x000067DF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 290X (0x000067E0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 295X2 (0x000067E1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 470 (0x000067ED) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 480 (0x000067EE) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 550 (0x00007310) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 560 (0x00007311) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 570 (0x00007312) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 580 (0x00007313) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 590 (0x00007314) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6400 (0x00007315) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6500 XT (0x00007316) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600 (0x00007317) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600 XT (0x00007318) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6700 XT (0x00007319) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6750 XT (0x0000731A) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6800 (0x0000731B) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6800 XT (0x0000731C) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6900 XT (0x0000731D) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6950 XT (0x0000731E) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7600 XT (0x0000731F) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7700 XT (0x00007320) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7800 XT (0x00007321) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XT (0x00007322) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XTX (0x00007323) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W6600 (0x00007324) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W6800 (0x00007325) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W7900 (0x00007326) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro VII (0x00007327) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro M6000 (0x00013F0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro P2000 (0x00001C30) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro P2200 (0x00001C31) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro T1000 (0x00001F95) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro T2000 (0x00001F96) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A4500 (0x00002594) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A5500 (0x00002596) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A1000 Laptop GPU (0x00002597) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A2000 Laptop GPU (0x00002598) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A3000 Laptop GPU (0x00002599) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD FirePro W5100 (0x00006710) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD FirePro W7100 (0x00006720) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD FirePro W8100 (0x00006730) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD FirePro W9100 (0x00006740) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro WX 2100 (0x00006750) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro WX 3100 (0x00006751) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro WX 3200 (0x00006752) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro WX 4100 (0x00006753) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro WX 5100 (0x00006754) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1630 (0x00001F84) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX130 (0x00001D10) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX230 (0x00001D13) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX250 (0x00001D52) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX330 (0x00001D53) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX450 (0x00001D52) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R7 240 (0x000067B0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R7 250 (0x000067B1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R7 250X (0x000067B2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R7 260 (0x000067B3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R7 260X (0x000067B4) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 380 (0x00007300) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 390 (0x00007301) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 390X (0x00007302) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5300 (0x00007303) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5300M (0x00007304) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5500 XT (0x00007305) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5600M (0x00007306) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 580X (0x00007307) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 590 GME (0x00007308) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600M (0x00007309) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
-}
+package api
+
+import (
+	"math/rand"
+	"time"
+)
+
+var rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+var WebGLs = []string{
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3090 (0x00002204) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 620 (0x00003EA0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) HD Graphics 400 Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 630 (0x00003E9B) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R5 340 (0x00006611) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Google)-&-ANGLE (Google, Vulkan 1.3.0 (SwiftShader Device (Subzero) (0x0000C0DE)), SwiftShader driver)||",
+	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 505, OpenGL ES 3.2)||",
+	"Google Inc. (Mali)-&-ANGLE (Mali, Mali-G68, OpenGL ES 3.2)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2070 with Max‑Q Design (0x00001F10) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 618, OpenGL ES 3.2)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel Open Source Technology Center, Mesa DRI Intel(R) HD Graphics 4600 (HSW GT2), OpenGL 4.5)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel Inc., Intel(R) Iris(TM) Plus Graphics 645, OpenGL 4.1)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) HD Graphics Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 618, OpenGL ES 3.2)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics (0x0000A7A1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) HD Graphics 3000 OpenGL Engine)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1660 Ti Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5700 XT Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 750 (0x0000AAAA) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Mesa)-&-ANGLE (Mesa/X11, AMD Radeon RX 580, OpenGL 4.6)||",
+	"Google Inc. (Gallium)-&-ANGLE (llvmpipe, LLVM 12.0, Mesa 20.3.4)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(TM) Xe Max Graphics, Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 Laptop GPU (0x000027E3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 600, OpenGL ES 3.0)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 380 (0x00006001) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 530, OpenGL ES 3.2)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 96 EUs (0x00006A2E) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN RTX (0x00002373) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Vega 8 Graphics, OpenGL 4.6)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 605, OpenGL ES 3.0)||",
+	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 650, OpenGL ES 3.2)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics G7 80EUs (0x00006A2F) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3070 Desktop GPU (0x00002474) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5600 XT Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 500 (0x0000A065) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 660, OpenGL ES 3.2)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 128 EUs (0x00006A20) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2060 Super (0x00002404) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Vega 10 Graphics, OpenGL 4.6)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 510 (0x0000A066) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 512, OpenGL ES 3.2)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 96 EUs 0x00006A21 Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2080 SUPER (0x000022AE) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600 XT Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 601, OpenGL ES 3.0)||",
+	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 540, OpenGL ES 3.2)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics DG1 (0x0000A782) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1080 Ti (0x00001B06) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6800 Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 602, OpenGL ES 3.0)||",
+	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 618M, OpenGL ES 3.2)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics P-series (0x00006A23) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3050 Laptop GPU (0x000025E6) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 570 Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 700 (0x0000A07B) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 530M, OpenGL ES 3.2)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 96 EUs 0x00006A22 Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3090 Ti (0x000024CD) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6700 XT Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 710 (0x0000A07C) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 680, OpenGL ES 3.2)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 128 EUs P-series (0x00006A24) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4060 (0x00005277) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7600 Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 712 (0x0000A07D) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 700, OpenGL ES 3.2)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 96 EUs Q-series (0x00006A25) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4070 Ti (0x000054C6) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XT Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) UHD Graphics 714 (0x0000A07E) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (Qualcomm)-&-ANGLE (Qualcomm, Adreno (TM) 710, OpenGL ES 3.2)||",
+	"Google Inc. (Intel)-&-ANGLE (Intel, Intel(R) Iris(R) Xe Graphics 128 EUs R-series (0x00006A26) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 Super (0x000055A8) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1650 (0x00001F82) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1650 Super (0x00001F91) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1660 (0x000021C0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN Xp (0x00001B02) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN RTX (0x00001E02) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3050 (0x00002589) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3050 Ti Laptop GPU (0x000025A0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3060 Laptop GPU (0x000025A2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 (0x0000210A) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 Ti (0x0000210F) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6400 (0x000073C6) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6500 XT (0x000073C7) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600 XT (0x000073C8) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6700 (0x000073C9) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6750 XT (0x000073CA) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6800 XT (0x000073CB) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6850 XT (0x000073CC) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6950 XT (0x000073CD) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7600 (0x000073CE) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7700 XT (0x000073D0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4060 (0x00002588) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4060 Ti (0x0000258A) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4070 (0x0000258B) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4070 Super (0x0000258C) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 (0x0000258D) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 Super (0x0000258E) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4090 (0x0000258F) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A2000 (0x00002590) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A4000 (0x00002591) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A5000 (0x00002592) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7800 XT (0x000073D1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XT (0x000073D2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XTX (0x000073D3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7950 XT (0x000073D4) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W6600 (0x000073D5) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W6800 (0x000073D6) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W7900 (0x000073D7) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro VII (0x000073D8) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7700 (0x000073D9) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 GRE (0x000073DA) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A6000 (0x00002593) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN Z (0x00001783) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro P4000 (0x00001BB1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro P5000 (0x00001BB0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro P6000 (0x00001BB2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX150 (0x00001D10) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX250 (0x00001D13) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX330 (0x00001D16) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX450 (0x00001D52) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A1000 (0x00002595) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 970 (0x000013C2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 980 (0x000013D0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 980 Ti (0x000017C8) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1080 (0x00001B80) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1080 Ti (0x00001B06) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2080 (0x00001E87) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2080 Ti (0x00001E05) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3060 (0x00002560) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3060 Ti (0x00002520) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3070 (0x00002440) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3070 Ti (0x00002509) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 (0x0000210A) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 Ti (0x0000210F) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3090 (0x00002404) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4090 (0x00002680) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN V (0x00001DB4) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN RTX (0x00001E02) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 480 (0x000067DF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 570 (0x000067DF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 580 (0x000067DF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 590 (0x000067DF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX Vega 56 (0x000068C7) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX Vega 64 (0x000068C8) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon VII (0x00008002) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5600 XT (0x000073FF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5700 (0x000073BF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5700 XT (0x000073BF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600 (0x000073EF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600 XT (0x000073C8) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6700 XT (0x000073DF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6750 XT (0x000073CA) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6800 (0x000073BF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6800 XT (0x000073CB) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6900 XT (0x000073AF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6950 XT (0x000073CD) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7600 XT (0x000073CE) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7700 XT (0x000073D0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7800 XT (0x000073D1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XT (0x000073D2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XTX (0x000073D3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A2000 (0x00002590) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A4000 (0x00002591) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A5000 (0x00002592) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A6000 (0x00002593) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro RTX 3000 (0x00001F04) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro RTX 4000 (0x00001EB1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro RTX 5000 (0x00001EB0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro RTX 6000 (0x00001EB2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro RTX 8000 (0x00001EB3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3050 (0x00002561) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3050 Ti (0x00002562) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4060 (0x00002563) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4060 Ti (0x00002564) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4070 (0x00002565) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4070 Ti (0x00002566) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 (0x00002567) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 Ti (0x00002568) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4090 (0x00002569) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6700 (0x000073CA) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6750 XT (0x000073CB) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6900 XT (0x000073CC) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7600 (0x000073CD) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7700 (0x000073CE) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7800 XT (0x000073CF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XT (0x000073D0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W6600 (0x000073D1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W6800 (0x000073D2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 760 (0x000010FD) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 770 (0x000010B1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 780 (0x000010B3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 780 Ti (0x000010B4) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 960 (0x000014C2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 970 (0x000014C3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 980 (0x000014E0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 980 Ti (0x000014F0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1050 (0x00001C81) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1050 Ti (0x00001C82) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1060 (0x00001C03) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1070 (0x00001B81) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1070 Ti (0x00001B82) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1080 (0x00001B80) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1080 Ti (0x00001B06) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX Titan X (0x00001B00) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2060 (0x00001E84) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2060 Super (0x00001F06) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2070 (0x00001F02) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2070 Super (0x000021C9) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2080 (0x00001E87) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2080 Super (0x000021C0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 2080 Ti (0x00001E02) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3060 (0x00002560) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3060 Ti (0x00002520) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3070 (0x00002440) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3070 Ti (0x000025A1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 (0x0000210A) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3080 Ti (0x0000210F) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3090 (0x00002404) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 3090 Ti (0x000024CD) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 (0x00002589) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4080 Ti (0x0000258D) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce RTX 4090 (0x00002680) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Titan RTX (0x00001E02) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA TITAN V (0x00001DB4) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon HD 7770 (0x00007122) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon HD 7850 (0x00007121) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon HD 7870 (0x00007120) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 270 (0x000067C0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 270X (0x000067C1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 280 (0x000067C2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 280X (0x000067C3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 290 (0x000067DF) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 290X (0x000067E0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 295X2 (0x000067E1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 470 (0x000067ED) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 480 (0x000067EE) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 550 (0x00007310) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 560 (0x00007311) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 570 (0x00007312) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 580 (0x00007313) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 590 (0x00007314) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6400 (0x00007315) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6500 XT (0x00007316) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600 (0x00007317) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600 XT (0x00007318) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6700 XT (0x00007319) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6750 XT (0x0000731A) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6800 (0x0000731B) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6800 XT (0x0000731C) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6900 XT (0x0000731D) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6950 XT (0x0000731E) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7600 XT (0x0000731F) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7700 XT (0x00007320) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7800 XT (0x00007321) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XT (0x00007322) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 7900 XTX (0x00007323) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W6600 (0x00007324) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W6800 (0x00007325) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro W7900 (0x00007326) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro VII (0x00007327) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro M6000 (0x00013F0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro P2000 (0x00001C30) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro P2200 (0x00001C31) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro T1000 (0x00001F95) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA Quadro T2000 (0x00001F96) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A4500 (0x00002594) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A5500 (0x00002596) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A1000 Laptop GPU (0x00002597) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A2000 Laptop GPU (0x00002598) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA RTX A3000 Laptop GPU (0x00002599) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD FirePro W5100 (0x00006710) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD FirePro W7100 (0x00006720) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD FirePro W8100 (0x00006730) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD FirePro W9100 (0x00006740) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro WX 2100 (0x00006750) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro WX 3100 (0x00006751) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro WX 3200 (0x00006752) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro WX 4100 (0x00006753) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon Pro WX 5100 (0x00006754) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce GTX 1630 (0x00001F84) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX130 (0x00001D10) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX230 (0x00001D13) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX250 (0x00001D52) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX330 (0x00001D53) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (NVIDIA)-&-ANGLE (NVIDIA, NVIDIA GeForce MX450 (0x00001D52) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R7 240 (0x000067B0) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R7 250 (0x000067B1) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R7 250X (0x000067B2) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R7 260 (0x000067B3) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R7 260X (0x000067B4) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 380 (0x00007300) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 390 (0x00007301) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon R9 390X (0x00007302) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5300 (0x00007303) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5300M (0x00007304) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5500 XT (0x00007305) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 5600M (0x00007306) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 580X (0x00007307) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 590 GME (0x00007308) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+	"Google Inc. (AMD)-&-ANGLE (AMD, AMD Radeon RX 6600M (0x00007309) Direct3D11 vs_5_0 ps_5_0, D3D11)||",
+}
@@ -1,42 +1,128 @@
-package helpers
-
-import (
-	_ "embed"
-	"sync"
-
-	"github.com/hajimehoshi/oto"
-)
-
-//go:embed assets/success.mp3
-var Success []byte
-
-//go:embed assets/decline.mp3
-var Decline []byte
-
-var (
-	audioCtx *oto.Context
-	ctxInit  sync.Once
-	initErr  error
-)
-
-var (
-	Carted     int
-	CheckedOut int
-	Mu         sync.Mutex
-	MaxRetries = 50000
-	SecChUa    = `"Not)A;Brand";v="8", "Chromium";v="138", "Google Chrome";v="138"`
-	UserAgent  = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/138.0.0.0 Safari/537.36"
-)
-
-var StateAbbreviations = map[string]string{
-	"Alabama": "AL", "Alaska": "AK", "Arizona": "AZ", "Arkansas": "AR", "California": "CA", "Colorado": "CO",
-	"Connecticut": "CT", "Delaware": "DE", "District of Columbia": "DC", "Florida": "FL", "Georgia": "GA",
-	"Hawaii": "HI", "Idaho": "ID", "Illinois": "IL", "Indiana": "IN", "Iowa": "IA", "Kansas": "KS",
-	"Kentucky": "KY", "Louisiana": "LA", "Maine": "ME", "Maryland": "MD", "Massachusetts": "MA",
-	"Michigan": "MI", "Minnesota": "MN", "Mississippi": "MS", "Missouri": "MO", "Montana": "MT", "Nebraska": "NE",
-	"Nevada": "NV", "New Hampshire": "NH", "New Jersey": "NJ", "New Mexico": "NM", "New York": "NY",
-	"North Carolina": "NC", "North Dakota": "ND", "Ohio": "OH", "Oklahoma": "OK", "Oregon": "OR",
-	"Pennsylvania": "PA", "Rhode Island": "RI", "South Carolina": "SC", "South Dakota": "SD",
-	"Tennessee": "TN", "Texas": "TX", "Utah": "UT", "Vermont": "VT", "Virginia": "VA", "Washington": "WA",
-	"West Virginia": "WV", "Wisconsin": "WI", "Wyoming": "WY",
-}
+package helpers
+
+import (
+	"context"
+	_ "embed"
+	"sync"
+	"time"
+
+	"github.com/hajimehoshi/oto"
+)
+
+//go:embed assets/success.mp3
+var Success []byte
+
+//go:embed assets/decline.mp3
+var Decline []byte
+
+var (
+	audioCtx *oto.Context
+	ctxInit  sync.Once
+	initErr  error
+)
+
+var (
+	interruptMu     sync.Mutex
+	interruptCancel context.CancelFunc
+)
+
+var (
+	Carted     int
+	CheckedOut int
+	Mu         sync.Mutex
+	MaxRetries = 50000
+	SecChUa    = `"Not)A;Brand";v="8", "Chromium";v="138", "Google Chrome";v="138"`
+	UserAgent  = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/138.0.0.0 Safari/537.36"
+)
+
+// SessionLifetime is how long a token is trusted when it doesn't carry its own expiry,
+// SessionMargin drops a session that would expire mid checkout
+const (
+	SessionLifetime = 24 * time.Hour
+	SessionMargin   = 10 * time.Minute
+)
+
+const (
+	StepQueued       TaskStep = "Queued"
+	StepLogin        TaskStep = "Login"
+	StepFetchProduct TaskStep = "FetchProduct"
+	StepAddress      TaskStep = "Address"
+	StepWaiting      TaskStep = "Waiting"
+	StepATC          TaskStep = "ATC"
+	StepRates        TaskStep = "Rates"
+	StepTaxes        TaskStep = "Taxes"
+	StepCreateOrder  TaskStep = "CreateOrder"
+	StepPayment      TaskStep = "Payment"
+	StepDone         TaskStep = "Done"
+	StepFailed       TaskStep = "Failed"
+	StepStopped      TaskStep = "Stopped"
+)
+
+// DefaultRetryPolicy applies wherever settings.json and the task row leave a field unset
+var DefaultRetryPolicy = RetryPolicy{
+	MonitorDelay: Millis(3500),
+	ErrorDelay:   Millis(3500),
+	MaxDelay:     Millis(30000),
+	Backoff:      Ratio(1.5),
+	Jitter:       Ratio(0.2),
+	MaxAttempts:  MaxRetries,
+}
+
+// CheckoutSteps is the order a desktop task moves through before it finishes, everything before
+// StepWaiting is pre-warm work that runs ahead of a scheduled start
+var CheckoutSteps = []TaskStep{
+	StepLogin, StepAddress, StepWaiting, StepFetchProduct, StepATC, StepRates, StepTaxes, StepCreateOrder, StepPayment,
+}
+
+var (
+	statusMu sync.Mutex
+	statuses []*TaskStatus
+)
+
+// sessions is the one session store every task in the process shares
+var sessions = &SessionStore{}
+
+// ProfileGroupColumn is the first of ProfileColumns, it picks the group a profile belongs to rather than a field
+const ProfileGroupColumn = "Profile Group Name"
+
+// ProfileColumns is every column profiles.csv is read by, matched by name or alias in any order and case.
+// The template createProfilesCSV writes is built from it, so new columns only need adding here
+var ProfileColumns = []ProfileColumn{
+	{Names: []string{ProfileGroupColumn, "Profile Group", "Group"}, Required: true},
+	{Names: []string{"Profile Name", "Profile"}, Required: true, Set: func(p *Profile, v string) { p.ProfileName = v }},
+	{Names: []string{"Email", "Email Address"}, Required: true, Set: func(p *Profile, v string) { p.Email = v }},
+	{Names: []string{"Name", "Full Name", "Shipping Name"}, Required: true, Set: func(p *Profile, v string) { p.Shipping.Name = v }},
+	{Names: []string{"Phone", "Phone Number"}, Required: true, Set: func(p *Profile, v string) { p.Phone = v }},
+	{Names: []string{"Address 1", "Address1", "Address Line 1", "Shipping Address 1"}, Required: true, Set: func(p *Profile, v string) { p.Shipping.Address1 = v }},
+	{Names: []string{"Address 2", "Address2", "Address Line 2", "Shipping Address 2"}, Set: func(p *Profile, v string) { p.Shipping.Address2 = v }},
+	{Names: []string{"City", "Shipping City"}, Required: true, Set: func(p *Profile, v string) { p.Shipping.City = v }},
+	{Names: []string{"Post Code", "Postcode", "Postal Code", "Zip", "Zip Code", "Shipping Post Code"}, Required: true, Set: func(p *Profile, v string) { p.Shipping.PostCode = v }},
+	{Names: []string{"Country", "Shipping Country"}, Set: func(p *Profile, v string) { p.Shipping.Country = v }},
+	{Names: []string{"State", "Province", "Shipping State"}, Required: true, Set: func(p *Profile, v string) { p.Shipping.State = v }},
+	{Names: []string{"Card Number", "Card"}, Set: func(p *Profile, v string) { p.CardNumber = v }},
+	{Names: []string{"Expiration Month", "Exp Month", "Expiry Month"}, Set: func(p *Profile, v string) { p.ExpMonth = v }},
+	{Names: []string{"Expiration Year", "Exp Year", "Expiry Year"}, Set: func(p *Profile, v string) { p.ExpYear = v }},
+	{Names: []string{"Security Code", "CVV", "CVC"}, Set: func(p *Profile, v string) { p.CVV = v }},
+	{Names: []string{"Phone Country Code", "Country Code", "Dial Code"}, Set: func(p *Profile, v string) { p.PhoneCode = v }},
+	{Names: []string{"Payment Method", "Payment"}, Set: func(p *Profile, v string) { p.Payment = v }},
+	{Names: []string{"Billing Name"}, Set: func(p *Profile, v string) { p.Billing.Name = v }},
+	{Names: []string{"Billing Address 1", "Billing Address1", "Billing Address Line 1"}, Set: func(p *Profile, v string) { p.Billing.Address1 = v }},
+	{Names: []string{"Billing Address 2", "Billing Address2", "Billing Address Line 2"}, Set: func(p *Profile, v string) { p.Billing.Address2 = v }},
+	{Names: []string{"Billing City"}, Set: func(p *Profile, v string) { p.Billing.City = v }},
+	{Names: []string{"Billing Post Code", "Billing Postcode", "Billing Postal Code", "Billing Zip", "Billing Zip Code"}, Set: func(p *Profile, v string) { p.Billing.PostCode = v }},
+	{Names: []string{"Billing Country"}, Set: func(p *Profile, v string) { p.Billing.Country = v }},
+	{Names: []string{"Billing State", "Billing Province"}, Set: func(p *Profile, v string) { p.Billing.State = v }},
+}
+
+var StateAbbreviations = map[string]string{
+	"Alabama": "AL", "Alaska": "AK", "Arizona": "AZ", "Arkansas": "AR", "California": "CA", "Colorado": "CO",
+	"Connecticut": "CT", "Delaware": "DE", "District of Columbia": "DC", "Florida": "FL", "Georgia": "GA",
+	"Hawaii": "HI", "Idaho": "ID", "Illinois": "IL", "Indiana": "IN", "Iowa": "IA", "Kansas": "KS",
+	"Kentucky": "KY", "Louisiana": "LA", "Maine": "ME", "Maryland": "MD", "Massachusetts": "MA",
+	"Michigan": "MI", "Minnesota": "MN", "Mississippi": "MS", "Missouri": "MO", "Montana": "MT", "Nebraska": "NE",
+	"Nevada": "NV", "New Hampshire": "NH", "New Jersey": "NJ", "New Mexico": "NM", "New York": "NY",
+	"North Carolina": "NC", "North Dakota": "ND", "Ohio": "OH", "Oklahoma": "OK", "Oregon": "OR",
+	"Pennsylvania": "PA", "Rhode Island": "RI", "South Carolina": "SC", "South Dakota": "SD",
+	"Tennessee": "TN", "Texas": "TX", "Utah": "UT", "Vermont": "VT", "Virginia": "VA", "Washington": "WA",
+	"West Virginia": "WV", "Wisconsin": "WI", "Wyoming": "WY",
+}
@@ -0,0 +1,96 @@
+package helpers
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ---------------------- RETRY POLICY ---------------------- \\
+// Merge returns p with every field that is set on override replaced
+func (p RetryPolicy) Merge(override RetryPolicy) RetryPolicy {
+	if override.MonitorDelay != nil {
+		p.MonitorDelay = override.MonitorDelay
+	}
+	if override.ErrorDelay != nil {
+		p.ErrorDelay = override.ErrorDelay
+	}
+	if override.MaxDelay != nil {
+		p.MaxDelay = override.MaxDelay
+	}
+	if override.Backoff != nil {
+		p.Backoff = override.Backoff
+	}
+	if override.Jitter != nil {
+		p.Jitter = override.Jitter
+	}
+	if override.MaxAttempts > 0 {
+		p.MaxAttempts = override.MaxAttempts
+	}
+	return p
+}
+
+// ErrorWait is how long to wait after the given number of consecutive failures
+func (p RetryPolicy) ErrorWait(failures int) time.Duration {
+	delay := float64(millis(p.ErrorDelay))
+	if backoff := ratio(p.Backoff); backoff > 1 && failures > 1 {
+		delay *= math.Pow(backoff, float64(failures-1))
+	}
+	if maxDelay := millis(p.MaxDelay); maxDelay > 0 && delay > float64(maxDelay) {
+		delay = float64(maxDelay)
+	}
+	return p.jitter(delay)
+}
+
+// MonitorWait is how long to wait before polling an unavailable product again
+func (p RetryPolicy) MonitorWait() time.Duration {
+	return p.jitter(float64(millis(p.MonitorDelay)))
+}
+
+func (p RetryPolicy) jitter(ms float64) time.Duration {
+	if jitter := ratio(p.Jitter); jitter > 0 {
+		ms += ms * jitter * (rand.Float64()*2 - 1)
+	}
+	return time.Duration(ms) * time.Millisecond
+}
+
+// Millis sets one of RetryPolicy's optional delays
+func Millis(ms int) *int {
+	return &ms
+}
+
+func millis(ms *int) int {
+	if ms == nil {
+		return 0
+	}
+	return *ms
+}
+
+// Ratio sets RetryPolicy's optional Backoff or Jitter
+func Ratio(r float64) *float64 {
+	return &r
+}
+
+func ratio(r *float64) float64 {
+	if r == nil {
+		return 0
+	}
+	return *r
+}
+
+// For resolves the policy a step runs with, step overrides win over the base policy
+func (r RetrySettings) For(step TaskStep) RetryPolicy {
+	return DefaultRetryPolicy.Merge(r.RetryPolicy).Merge(r.Steps[step])
+}
+
+// Override layers a task row's policy over the base and every step so the row always wins
+func (r RetrySettings) Override(row RetryPolicy) RetrySettings {
+	merged := RetrySettings{
+		RetryPolicy: r.RetryPolicy.Merge(row),
+		Steps:       make(map[TaskStep]RetryPolicy, len(r.Steps)),
+	}
+	for step, policy := range r.Steps {
+		merged.Steps[step] = policy.Merge(row)
+	}
+	return merged
+}
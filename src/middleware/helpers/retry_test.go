@@ -0,0 +1,86 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyMerge(t *testing.T) {
+	base := DefaultRetryPolicy
+
+	merged := base.Merge(RetryPolicy{})
+	if *merged.ErrorDelay != 3500 || *merged.Backoff != 1.5 || *merged.Jitter != 0.2 || merged.MaxAttempts != MaxRetries {
+		t.Errorf("empty override changed the policy: %+v", merged)
+	}
+
+	// Zero is a value like any other, only unset fields keep the base
+	merged = base.Merge(RetryPolicy{ErrorDelay: Millis(0), Backoff: Ratio(0), Jitter: Ratio(0), MaxAttempts: 2})
+	if *merged.ErrorDelay != 0 || *merged.Backoff != 0 || *merged.Jitter != 0 || merged.MaxAttempts != 2 {
+		t.Errorf("zero override was ignored: %+v", merged)
+	}
+	if *merged.MonitorDelay != 3500 || *merged.MaxDelay != 30000 {
+		t.Errorf("unset fields lost the base: %+v", merged)
+	}
+	if *base.Jitter != 0.2 {
+		t.Errorf("Merge changed the base policy's Jitter to %v", *base.Jitter)
+	}
+}
+
+func TestRetrySettingsOverride(t *testing.T) {
+	settings := RetrySettings{
+		RetryPolicy: RetryPolicy{ErrorDelay: Millis(1000)},
+		Steps:       map[TaskStep]RetryPolicy{StepATC: {ErrorDelay: Millis(200), MaxAttempts: 10}},
+	}
+
+	if got := *settings.For(StepATC).ErrorDelay; got != 200 {
+		t.Errorf("step ErrorDelay = %d, want the step override 200", got)
+	}
+	if got := *settings.For(StepLogin).ErrorDelay; got != 1000 {
+		t.Errorf("ErrorDelay = %d, want the base 1000", got)
+	}
+
+	row := settings.Override(RetryPolicy{ErrorDelay: Millis(50)})
+	if got := *row.For(StepATC).ErrorDelay; got != 50 {
+		t.Errorf("step ErrorDelay = %d, want the row's 50", got)
+	}
+	if got := row.For(StepATC).MaxAttempts; got != 10 {
+		t.Errorf("step MaxAttempts = %d, want the step's 10 kept", got)
+	}
+}
+
+func TestRetryPolicyErrorWait(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   RetryPolicy
+		failures int
+		want     time.Duration
+	}{
+		{name: "first failure", policy: RetryPolicy{ErrorDelay: Millis(1000), Backoff: Ratio(2)}, failures: 1, want: time.Second},
+		{name: "second failure", policy: RetryPolicy{ErrorDelay: Millis(1000), Backoff: Ratio(2)}, failures: 2, want: 2 * time.Second},
+		{name: "third failure", policy: RetryPolicy{ErrorDelay: Millis(1000), Backoff: Ratio(2)}, failures: 3, want: 4 * time.Second},
+		{name: "capped", policy: RetryPolicy{ErrorDelay: Millis(1000), Backoff: Ratio(2), MaxDelay: Millis(5000)}, failures: 4, want: 5 * time.Second},
+		{name: "no cap", policy: RetryPolicy{ErrorDelay: Millis(1000), Backoff: Ratio(2), MaxDelay: Millis(0)}, failures: 4, want: 8 * time.Second},
+		{name: "flat backoff", policy: RetryPolicy{ErrorDelay: Millis(1000), Backoff: Ratio(1)}, failures: 5, want: time.Second},
+		{name: "zero backoff", policy: RetryPolicy{ErrorDelay: Millis(1000), Backoff: Ratio(0)}, failures: 5, want: time.Second},
+		{name: "no delay", policy: RetryPolicy{ErrorDelay: Millis(0), Backoff: Ratio(2)}, failures: 3, want: 0},
+	}
+
+	for _, test := range tests {
+		test.policy.Jitter = Ratio(0)
+		if got := test.policy.ErrorWait(test.failures); got != test.want {
+			t.Errorf("%s: ErrorWait(%d) = %v, want %v", test.name, test.failures, got, test.want)
+		}
+	}
+}
+
+func TestRetryPolicyJitter(t *testing.T) {
+	policy := RetryPolicy{ErrorDelay: Millis(1000), MonitorDelay: Millis(1000), Jitter: Ratio(0.2)}
+	for range 100 {
+		if got := policy.ErrorWait(1); got < 800*time.Millisecond || got > 1200*time.Millisecond {
+			t.Fatalf("ErrorWait = %v, want within 20%% of 1s", got)
+		}
+		if got := policy.MonitorWait(); got < 800*time.Millisecond || got > 1200*time.Millisecond {
+			t.Fatalf("MonitorWait = %v, want within 20%% of 1s", got)
+		}
+	}
+}
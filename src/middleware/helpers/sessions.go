@@ -0,0 +1,141 @@
+package helpers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	storage "popmart/src/middleware/helpers/storage"
+)
+
+// ---------------------- SESSION STORE ---------------------- \\
+// FetchSession returns the saved session for an account, or an error when there is none or it has expired
+func FetchSession(logger *ColorizedLogger, taskId, accountEmail string) (UserData, error) {
+	session, ok, err := sessions.Get(accountEmail)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Task %s: Failed To Load Sessions File: %s", taskId, err.Error()))
+		return UserData{}, err
+	}
+
+	if !ok {
+		return UserData{}, fmt.Errorf("no session was found")
+	}
+
+	if !session.ExpiresAt.IsZero() && time.Now().Add(SessionMargin).After(session.ExpiresAt) {
+		return UserData{}, fmt.Errorf("session expired at %s", session.ExpiresAt.Format(time.RFC3339))
+	}
+
+	return UserData{
+		AccessToken: session.AccessToken,
+		GID:         session.GID,
+	}, nil
+}
+
+func SaveSession(logger *ColorizedLogger, taskId, accountEmail, accessToken string, gid int) {
+	issuedAt := time.Now()
+	err := sessions.Put(Session{
+		AccountEmail: accountEmail,
+		AccessToken:  accessToken,
+		GID:          gid,
+		IssuedAt:     issuedAt,
+		ExpiresAt:    TokenExpiry(accessToken, issuedAt),
+	})
+	if err != nil {
+		logger.Error(fmt.Sprintf("Task %s: Failed To Save Sessions To Sessions File: %s", taskId, err.Error()))
+		return
+	}
+
+	logger.Info(fmt.Sprintf("Task %s: Session Successfully Saved For %s", taskId, accountEmail))
+}
+
+// Get returns an account's session, reading sessions.json from storage only the first time
+func (s *SessionStore) Get(accountEmail string) (Session, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return Session{}, false, err
+	}
+
+	for _, session := range s.sessions {
+		if session.AccountEmail == accountEmail {
+			return session, true, nil
+		}
+	}
+	return Session{}, false, nil
+}
+
+// Put adds or replaces an account's session and writes sessions.json back to storage while still holding the lock,
+// so concurrent logins can't overwrite each other
+func (s *SessionStore) Put(session Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return err
+	}
+
+	updated := make([]Session, 0, len(s.sessions)+1)
+	found := false
+	for _, existing := range s.sessions {
+		if existing.AccountEmail == session.AccountEmail {
+			existing, found = session, true
+		}
+		updated = append(updated, existing)
+	}
+	if !found {
+		updated = append(updated, session)
+	}
+
+	data, err := json.MarshalIndent(updated, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := storage.Write(storage.SessionsFile, data); err != nil {
+		return err
+	}
+	s.sessions = updated
+	return nil
+}
+
+func (s *SessionStore) load() error {
+	if s.loaded {
+		return nil
+	}
+
+	data, err := storage.Read(storage.SessionsFile)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	var loaded []Session
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &loaded); err != nil {
+			return err
+		}
+	}
+
+	s.sessions, s.loaded = loaded, true
+	return nil
+}
+
+// TokenExpiry reads the exp claim when the token is a JWT, otherwise it trusts the token for SessionLifetime
+func TokenExpiry(token string, issuedAt time.Time) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) == 3 {
+		if payload, err := base64.RawURLEncoding.DecodeString(parts[1]); err == nil {
+			var claims struct {
+				Exp int64 `json:"exp"`
+			}
+			if json.Unmarshal(payload, &claims) == nil && claims.Exp > 0 {
+				return time.Unix(claims.Exp, 0)
+			}
+		}
+	}
+	return issuedAt.Add(SessionLifetime)
+}
@@ -0,0 +1,175 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// ---------------------- STATUS REGISTRY ---------------------- \\
+func ResetStatuses() {
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	statuses = nil
+}
+
+func TrackTask(task Task) *TaskStatus {
+	statusMu.Lock()
+	defer statusMu.Unlock()
+
+	now := time.Now()
+	status := &TaskStatus{
+		TaskId:    task.TaskId,
+		Account:   strings.SplitN(task.Account, ":", 2)[0],
+		Profile:   task.Profile.ProfileName,
+		Step:      StepQueued,
+		Attempts:  make(map[TaskStep]int),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	statuses = append(statuses, status)
+	return status
+}
+
+// TaskStatuses returns a copy of every tracked status, safe to read while tasks keep running
+func TaskStatuses() []TaskStatus {
+	statusMu.Lock()
+	defer statusMu.Unlock()
+
+	snapshot := make([]TaskStatus, 0, len(statuses))
+	for _, status := range statuses {
+		s := *status
+		s.Attempts = make(map[TaskStep]int, len(status.Attempts))
+		for step, count := range status.Attempts {
+			s.Attempts[step] = count
+		}
+		snapshot = append(snapshot, s)
+	}
+	return snapshot
+}
+
+// StopTask cancels a single task, or marks it so it stops as soon as a worker picks it up
+func StopTask(taskId string) bool {
+	statusMu.Lock()
+	defer statusMu.Unlock()
+
+	for _, status := range statuses {
+		if status.TaskId != taskId {
+			continue
+		}
+
+		status.stopRequested = true
+		if status.cancel != nil {
+			status.cancel()
+		}
+		return true
+	}
+	return false
+}
+
+func StepCounts() map[TaskStep]int {
+	statusMu.Lock()
+	defer statusMu.Unlock()
+
+	counts := make(map[TaskStep]int)
+	for _, status := range statuses {
+		counts[status.Step]++
+	}
+	return counts
+}
+
+// ---------------------- STATUS UPDATES ---------------------- \\
+// Every method is a no-op on a nil status so steps can run outside of a tracked task group
+func (s *TaskStatus) Bind(cancel context.CancelFunc) {
+	if s == nil {
+		return
+	}
+
+	statusMu.Lock()
+	defer statusMu.Unlock()
+
+	s.cancel = cancel
+	if s.stopRequested {
+		cancel()
+	}
+}
+
+func (s *TaskStatus) Enter(step TaskStep) {
+	if s == nil {
+		return
+	}
+
+	statusMu.Lock()
+	defer statusMu.Unlock()
+
+	now := time.Now()
+	if s.StartedAt.IsZero() {
+		s.StartedAt = now
+	}
+	s.Step = step
+	s.Attempts[step]++
+	s.StepStartedAt = now
+	s.UpdatedAt = now
+}
+
+func (s *TaskStatus) Retry(message string) {
+	if s == nil {
+		return
+	}
+
+	statusMu.Lock()
+	defer statusMu.Unlock()
+
+	s.Attempts[s.Step]++
+	s.LastError = strings.TrimPrefix(message, "Task "+s.TaskId+": ")
+	s.UpdatedAt = time.Now()
+}
+
+// Note records what a task is waiting on without counting it as a failed attempt
+func (s *TaskStatus) Note(message string) {
+	if s == nil {
+		return
+	}
+
+	statusMu.Lock()
+	defer statusMu.Unlock()
+
+	s.LastError = strings.TrimPrefix(message, "Task "+s.TaskId+": ")
+	s.UpdatedAt = time.Now()
+}
+
+// Select records the products and sizes a task picked so the dashboard and run summary can show what was bought
+func (s *TaskStatus) Select(product, sku string) {
+	if s == nil {
+		return
+	}
+
+	statusMu.Lock()
+	defer statusMu.Unlock()
+
+	s.Product, s.Sku = product, sku
+	s.UpdatedAt = time.Now()
+}
+
+func (s *TaskStatus) Finish(err error) {
+	if s == nil {
+		return
+	}
+
+	statusMu.Lock()
+	defer statusMu.Unlock()
+
+	now := time.Now()
+	switch {
+	case err == nil:
+		s.Step = StepDone
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		s.Step = StepStopped
+	default:
+		s.Step = StepFailed
+		s.LastError = err.Error()
+	}
+	s.FinishedAt = now
+	s.UpdatedAt = now
+}
@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"regexp"
+	"sync"
+)
+
+// Document names, the file backend keeps each one as a file of that name in the data directory
+const (
+	TasksFile    = "tasks.csv"
+	ProfilesFile = "profiles.csv"
+	ProxiesFile  = "proxies.json"
+	AccountsFile = "accounts.json"
+	SessionsFile = "sessions.json"
+	SettingsFile = "settings.json"
+	VaultFile    = "vault.json"
+)
+
+const (
+	// EnvBackend picks the storage backend, "file" (the default) or "sqlite"
+	EnvBackend = "POPMART_STORAGE"
+	// EnvDataDir moves the data directory away from ~/Popmart CLI
+	EnvDataDir = "POPMART_DATA_DIR"
+	// EnvWorkspace picks a named workspace inside the data directory
+	EnvWorkspace = "POPMART_WORKSPACE"
+	// EnvPassphrase unlocks an encrypted workspace without a prompt
+	EnvPassphrase = "POPMART_VAULT_PASSPHRASE"
+
+	DefaultWorkspace = "default"
+	workspacesDir    = "workspaces"
+
+	BackendFile   = "file"
+	BackendSQLite = "sqlite"
+
+	databaseFile = "popmart.db"
+	// importedSuffix is added to document files once the SQLite backend has imported them
+	importedSuffix = ".imported"
+)
+
+// Documents lists every document the bot keeps, a new SQLite database imports the files it finds for these
+var Documents = []string{TasksFile, ProfilesFile, ProxiesFile, AccountsFile, SessionsFile, SettingsFile, VaultFile}
+
+// Sensitive lists the documents holding cards, passwords or tokens, the vault encrypts these
+var Sensitive = []string{ProfilesFile, AccountsFile, SessionsFile, SettingsFile}
+
+// Vault format, scrypt parameters follow the package's recommendation for interactive logins
+const (
+	vaultVersion = 1
+	vaultMagic   = "POPMART-VAULT-1\n"
+	vaultCheck   = "popmart-vault"
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	keyLength    = 32
+	saltLength   = 16
+)
+
+var workspaceName = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+var (
+	// currentMu guards the active store, EncryptDocuments swaps it for the vault while tasks are reading it
+	currentMu  sync.Mutex
+	current    Store
+	currentErr error
+	opened     bool
+
+	// Set from --data-dir and --workspace, they win over the environment
+	dataDirOverride   string
+	workspaceOverride string
+
+	// passphrasePrompt asks for the vault passphrase when POPMART_VAULT_PASSPHRASE isn't set, nil in headless mode
+	passphrasePrompt func() (string, error)
+
+	// warning reports things storage did or found that don't stop it, like a plain text document in a vault or files
+	// moved aside by a database import, stderr when unset
+	warning func(message string)
+
+	// temps are the TempCopy files not removed yet, RemoveTemps clears them when the app exits early
+	tempsMu sync.Mutex
+	temps   = make(map[string]bool)
+)
@@ -0,0 +1,128 @@
+package storage
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// ---------------------- FILE BACKEND ---------------------- \\
+func (s *fileStore) Read(name string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(s.dir, name))
+}
+
+func (s *fileStore) Write(name string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return WriteFileAtomic(filepath.Join(s.dir, name), data, 0600)
+}
+
+// Update holds the store's lock for the whole read and write, so updates within the process can't overwrite each other
+func (s *fileStore) Update(name string, fn func(data []byte) ([]byte, error)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := filepath.Join(s.dir, name)
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	updated, err := fn(data)
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(path, updated, 0600)
+}
+
+func (s *fileStore) Exists(name string) (bool, error) {
+	_, err := os.Stat(filepath.Join(s.dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *fileStore) Path(name string) (string, bool) {
+	return filepath.Join(s.dir, name), true
+}
+
+func (s *fileStore) Close() error {
+	return nil
+}
+
+// WriteFileAtomic writes to a temp file beside path and renames it over path, so a crash never leaves a half written file
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpPath, perm); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// ---------------------- TEMP COPIES ---------------------- \
+// TempCopy writes data to a temp file only the user can read, for handing a document to an outside editor. The returned
+// func deletes it, and RemoveTemps deletes it too if the app exits first
+func TempCopy(name string, data []byte) (string, func(), error) {
+	tmp, err := os.CreateTemp("", "popmart_*_"+name)
+	if err != nil {
+		return "", nil, err
+	}
+	path := tmp.Name()
+
+	tempsMu.Lock()
+	temps[path] = true
+	tempsMu.Unlock()
+
+	remove := func() {
+		tempsMu.Lock()
+		defer tempsMu.Unlock()
+		delete(temps, path)
+		os.Remove(path)
+	}
+
+	if err := tmp.Chmod(0o600); err != nil && !errors.Is(err, errors.ErrUnsupported) {
+		tmp.Close()
+		remove()
+		return "", nil, err
+	}
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		remove()
+		return "", nil, err
+	}
+	return path, remove, nil
+}
+
+// RemoveTemps deletes every TempCopy file still on disk, it's called before the app exits
+func RemoveTemps() {
+	tempsMu.Lock()
+	defer tempsMu.Unlock()
+
+	for path := range temps {
+		os.Remove(path)
+		delete(temps, path)
+	}
+}
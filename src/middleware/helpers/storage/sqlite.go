@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// ---------------------- SQLITE BACKEND ---------------------- \\
+// openSQLite opens popmart.db in dir, the first time it also imports any document files already there
+func openSQLite(dir string) (*sqliteStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	// Immediate transactions take the write lock up front, so two updates never deadlock upgrading a read lock,
+	// secure delete zeroes replaced rows so plain text from before the vault doesn't linger in free pages
+	path := filepath.Join(dir, databaseFile)
+	dsn := path + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=synchronous(FULL)&_pragma=secure_delete(ON)&_txlock=immediate"
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS documents (
+		name       TEXT PRIMARY KEY,
+		data       BLOB NOT NULL,
+		updated_at INTEGER NOT NULL
+	)`)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create documents table: %w", err)
+	}
+	os.Chmod(path, 0600)
+
+	s := &sqliteStore{db: db}
+	if err := s.importFiles(dir); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *sqliteStore) Read(name string) ([]byte, error) {
+	var data []byte
+	err := s.db.QueryRow(`SELECT data FROM documents WHERE name = ?`, name).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%s: %w", name, fs.ErrNotExist)
+	}
+	return data, err
+}
+
+func (s *sqliteStore) Write(name string, data []byte) error {
+	return write(s.db, name, data)
+}
+
+func (s *sqliteStore) Update(name string, fn func(data []byte) ([]byte, error)) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var data []byte
+	err = tx.QueryRow(`SELECT data FROM documents WHERE name = ?`, name).Scan(&data)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	updated, err := fn(data)
+	if err != nil {
+		return err
+	}
+
+	if err := write(tx, name, updated); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *sqliteStore) Exists(name string) (bool, error) {
+	var found int
+	err := s.db.QueryRow(`SELECT 1 FROM documents WHERE name = ?`, name).Scan(&found)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *sqliteStore) Path(name string) (string, bool) {
+	return "", false
+}
+
+func (s *sqliteStore) Close() error {
+	return s.db.Close()
+}
+
+// importFiles copies every document file in dir that the database doesn't hold yet, so switching backends keeps existing data.
+// Once the import commits the files are renamed to *.imported, so plain copies don't sit next to the database unnoticed
+func (s *sqliteStore) importFiles(dir string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	imported := make(map[string]bool)
+	for _, name := range Documents {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to import %s: %w", name, err)
+		}
+
+		result, err := tx.Exec(`INSERT INTO documents (name, data, updated_at) VALUES (?, ?, ?) ON CONFLICT(name) DO NOTHING`, name, data, time.Now().Unix())
+		if err != nil {
+			return fmt.Errorf("failed to import %s: %w", name, err)
+		}
+		rows, _ := result.RowsAffected()
+		imported[name] = rows > 0
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	for _, name := range Documents {
+		added, ok := imported[name]
+		if !ok {
+			continue
+		}
+
+		path := filepath.Join(dir, name)
+		if err := os.Rename(path, path+importedSuffix); err != nil {
+			report(fmt.Sprintf("Failed To Move %s Aside After Importing It Into %s: %s", name, databaseFile, err.Error()))
+			continue
+		}
+		if added {
+			report(fmt.Sprintf("Imported %s Into %s, The File Was Moved To %s%s", name, databaseFile, name, importedSuffix))
+		} else {
+			report(fmt.Sprintf("%s Was Already In %s, The File Was Moved To %s%s", name, databaseFile, name, importedSuffix))
+		}
+	}
+	return nil
+}
+
+// write upserts a document through either the database or an open transaction
+func write(db execer, name string, data []byte) error {
+	if data == nil {
+		data = []byte{}
+	}
+	_, err := db.Exec(`INSERT INTO documents (name, data, updated_at) VALUES (?, ?, ?)
+		ON CONFLICT(name) DO UPDATE SET data = excluded.data, updated_at = excluded.updated_at`, name, data, time.Now().Unix())
+	return err
+}
@@ -0,0 +1,207 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ---------------------- STORE SELECTION ---------------------- \\
+// Current returns the store picked by POPMART_STORAGE for the active workspace, opening it on first use
+func Current() (Store, error) {
+	currentMu.Lock()
+	defer currentMu.Unlock()
+	return currentLocked()
+}
+
+// currentLocked is Current for callers already holding currentMu
+func currentLocked() (Store, error) {
+	if !opened {
+		current, currentErr = openCurrent()
+		opened = true
+	}
+	return current, currentErr
+}
+
+// openCurrent opens the active workspace and unlocks its vault when it has one
+func openCurrent() (Store, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := Open(os.Getenv(EnvBackend), dir)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := store.Read(VaultFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+
+	passphrase, err := readPassphrase()
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+
+	vault, err := openVault(store, meta, passphrase)
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+	return vault, nil
+}
+
+// Open opens a backend by name over a data directory, an empty name means the file backend
+func Open(backend, dir string) (Store, error) {
+	switch strings.ToLower(strings.TrimSpace(backend)) {
+	case "", BackendFile:
+		return &fileStore{dir: dir}, nil
+	case BackendSQLite:
+		return openSQLite(dir)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q, expected %q or %q", backend, BackendFile, BackendSQLite)
+	}
+}
+
+// report passes a message to the warning hook, stderr when none is set
+func report(message string) {
+	if warning == nil {
+		fmt.Fprintln(os.Stderr, message)
+		return
+	}
+	warning(message)
+}
+
+// ---------------------- DATA DIRECTORY ---------------------- \\
+// Configure points storage at another data directory and/or workspace, empty values keep the environment or default.
+// It has to run before anything reads or writes a document
+func Configure(dataDir, workspace string) error {
+	if workspace != "" {
+		if err := ValidateWorkspace(workspace); err != nil {
+			return err
+		}
+	}
+	dataDirOverride, workspaceOverride = dataDir, workspace
+	return nil
+}
+
+// RootDir is the top level data directory, --data-dir, then POPMART_DATA_DIR, then ~/Popmart CLI
+func RootDir() (string, error) {
+	dir := dataDirOverride
+	if dir == "" {
+		dir = os.Getenv(EnvDataDir)
+	}
+	if dir != "" {
+		return filepath.Abs(dir)
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve user home directory: %w", err)
+	}
+	return filepath.Join(home, "Popmart CLI"), nil
+}
+
+// Workspace returns the active workspace name, "default" when none was picked
+func Workspace() string {
+	if workspaceOverride != "" {
+		return workspaceOverride
+	}
+	if name := strings.TrimSpace(os.Getenv(EnvWorkspace)); name != "" {
+		return name
+	}
+	return DefaultWorkspace
+}
+
+// DataDir is the directory the active workspace keeps its documents and database in. The default workspace
+// is the root itself so existing installs keep their files, every other one lives in workspaces/<name>
+func DataDir() (string, error) {
+	root, err := RootDir()
+	if err != nil {
+		return "", err
+	}
+
+	workspace := Workspace()
+	if workspace == DefaultWorkspace {
+		return root, nil
+	}
+	if err := ValidateWorkspace(workspace); err != nil {
+		return "", err
+	}
+	return filepath.Join(root, workspacesDir, workspace), nil
+}
+
+// Workspaces lists the default workspace followed by every named one created under the root
+func Workspaces() ([]string, error) {
+	root, err := RootDir()
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{DefaultWorkspace}
+	entries, err := os.ReadDir(filepath.Join(root, workspacesDir))
+	if errors.Is(err, fs.ErrNotExist) {
+		return names, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() && ValidateWorkspace(entry.Name()) == nil {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// ValidateWorkspace keeps workspace names to a single folder name
+func ValidateWorkspace(name string) error {
+	if !workspaceName.MatchString(name) {
+		return fmt.Errorf("invalid workspace %q, use letters, numbers, '-' and '_'", name)
+	}
+	return nil
+}
+
+// ---------------------- CURRENT STORE SHORTCUTS ---------------------- \\
+func Read(name string) ([]byte, error) {
+	store, err := Current()
+	if err != nil {
+		return nil, err
+	}
+	return store.Read(name)
+}
+
+func Write(name string, data []byte) error {
+	store, err := Current()
+	if err != nil {
+		return err
+	}
+	return store.Write(name, data)
+}
+
+func Update(name string, fn func(data []byte) ([]byte, error)) error {
+	store, err := Current()
+	if err != nil {
+		return err
+	}
+	return store.Update(name, fn)
+}
+
+func Exists(name string) (bool, error) {
+	store, err := Current()
+	if err != nil {
+		return false, err
+	}
+	return store.Exists(name)
+}
@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"crypto/cipher"
+	"database/sql"
+	"sync"
+	"sync/atomic"
+)
+
+// Store keeps the bot's documents (tasks.csv, proxies.json, ...) by name
+type Store interface {
+	// Read returns a document, wrapping fs.ErrNotExist when it has never been written
+	Read(name string) ([]byte, error)
+	Write(name string, data []byte) error
+	// Update reads a document (nil when missing), passes it to fn and stores what fn returns as one transaction
+	Update(name string, fn func(data []byte) ([]byte, error)) error
+	Exists(name string) (bool, error)
+	// Path returns the file a document lives in, false when it only lives inside a database
+	Path(name string) (string, bool)
+	Close() error
+}
+
+type fileStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+type sqliteStore struct {
+	db *sql.DB
+}
+
+// vaultStore encrypts the sensitive documents of the store it wraps with AES-GCM
+type vaultStore struct {
+	Store
+	aead cipher.AEAD
+	meta vaultMeta
+	// migrated mirrors meta.Migrated for reads from other goroutines while EncryptDocuments sets it
+	migrated atomic.Bool
+
+	// warned holds the plain text documents already warned about, so each is reported once
+	warnedMu sync.Mutex
+	warned   map[string]bool
+}
+
+// vaultMeta is vault.json, it holds everything needed to derive the key again but never the key itself
+type vaultMeta struct {
+	Version int    `json:"version"`
+	KDF     string `json:"kdf"`
+	N       int    `json:"n"`
+	R       int    `json:"r"`
+	P       int    `json:"p"`
+	Salt    []byte `json:"salt"`
+	Check   []byte `json:"check"`
+	// Migrated is set once EncryptDocuments has encrypted every sensitive document, plain text is refused after that
+	Migrated bool `json:"migrated,omitempty"`
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
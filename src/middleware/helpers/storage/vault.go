@@ -0,0 +1,303 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// ---------------------- VAULT ---------------------- \\
+// SetPassphrasePrompt is how the menus ask for the vault passphrase, without one a locked workspace needs POPMART_VAULT_PASSPHRASE
+func SetPassphrasePrompt(prompt func() (string, error)) {
+	passphrasePrompt = prompt
+}
+
+// SetWarning is where storage reports plain text documents it still reads during a migration and files it moves aside
+func SetWarning(warn func(message string)) {
+	warning = warn
+}
+
+// Encrypted reports whether the active workspace has a vault
+func Encrypted() (bool, error) {
+	store, err := Current()
+	if err != nil {
+		return false, err
+	}
+	_, ok := store.(*vaultStore)
+	return ok, nil
+}
+
+// EncryptDocuments creates the workspace's vault with passphrase when it doesn't have one yet, then encrypts every
+// sensitive document still in plain text and returns their names. Running it again finishes an interrupted migration.
+// It holds the store lock throughout, so nothing reads a half migrated workspace
+func EncryptDocuments(passphrase string) ([]string, error) {
+	currentMu.Lock()
+	defer currentMu.Unlock()
+
+	vault, err := currentVault(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	var encrypted []string
+	for _, name := range Sensitive {
+		data, err := vault.Store.Read(name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return encrypted, err
+		}
+		if bytes.HasPrefix(data, []byte(vaultMagic)) {
+			continue
+		}
+
+		if err := vault.Write(name, data); err != nil {
+			return encrypted, fmt.Errorf("failed to encrypt %s: %w", name, err)
+		}
+		encrypted = append(encrypted, name)
+	}
+
+	if !vault.migrated.Load() {
+		vault.meta.Migrated = true
+		if err := vault.saveMeta(); err != nil {
+			return encrypted, err
+		}
+		vault.migrated.Store(true)
+	}
+	return encrypted, removeImported()
+}
+
+// removeImported deletes the plain *.imported copies of sensitive documents the SQLite import left behind
+func removeImported() error {
+	dir, err := DataDir()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range Sensitive {
+		err := os.Remove(filepath.Join(dir, name+importedSuffix))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to remove %s%s: %w", name, importedSuffix, err)
+		}
+		report(fmt.Sprintf("Removed The Plain Text %s%s Left From The Database Import", name, importedSuffix))
+	}
+	return nil
+}
+
+// currentVault returns the workspace's vault, creating it with passphrase and making it the active store when there's
+// none yet, currentMu must be held
+func currentVault(passphrase string) (*vaultStore, error) {
+	store, err := currentLocked()
+	if err != nil {
+		return nil, err
+	}
+	if vault, ok := store.(*vaultStore); ok {
+		return vault, nil
+	}
+
+	if passphrase == "" {
+		return nil, fmt.Errorf("a passphrase is required to create the vault")
+	}
+	vault, err := createVault(store, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	current = vault
+	return vault, nil
+}
+
+// createVault derives a key from a new random salt and saves vault.json with a check value to verify passphrases against
+func createVault(store Store, passphrase string) (*vaultStore, error) {
+	meta := vaultMeta{Version: vaultVersion, KDF: "scrypt", N: scryptN, R: scryptR, P: scryptP, Salt: make([]byte, saltLength)}
+	if _, err := rand.Read(meta.Salt); err != nil {
+		return nil, err
+	}
+
+	aead, err := deriveAEAD(meta, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	vault := &vaultStore{Store: store, aead: aead, meta: meta}
+
+	vault.meta.Check, err = vault.seal(VaultFile, []byte(vaultCheck))
+	if err != nil {
+		return nil, err
+	}
+	if err := vault.saveMeta(); err != nil {
+		return nil, err
+	}
+	return vault, nil
+}
+
+// openVault checks passphrase against vault.json and wraps store with the key it derives
+func openVault(store Store, data []byte, passphrase string) (*vaultStore, error) {
+	var meta vaultMeta
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", VaultFile, err)
+	}
+	if meta.Version != vaultVersion || meta.KDF != "scrypt" {
+		return nil, fmt.Errorf("unsupported vault version %d (%s)", meta.Version, meta.KDF)
+	}
+
+	aead, err := deriveAEAD(meta, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	vault := &vaultStore{Store: store, aead: aead, meta: meta}
+
+	check, err := vault.open(VaultFile, meta.Check)
+	if err != nil || string(check) != vaultCheck {
+		return nil, fmt.Errorf("wrong vault passphrase")
+	}
+	vault.migrated.Store(meta.Migrated)
+	return vault, nil
+}
+
+func (s *vaultStore) saveMeta() error {
+	data, err := json.MarshalIndent(s.meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.Store.Write(VaultFile, data)
+}
+
+func deriveAEAD(meta vaultMeta, passphrase string) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), meta.Salt, meta.N, meta.R, meta.P, keyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func readPassphrase() (string, error) {
+	if passphrase := os.Getenv(EnvPassphrase); passphrase != "" {
+		return passphrase, nil
+	}
+	if passphrasePrompt == nil {
+		return "", fmt.Errorf("this workspace is encrypted, set %s to unlock it", EnvPassphrase)
+	}
+	return passphrasePrompt()
+}
+
+// ---------------------- VAULT STORE ---------------------- \\
+// Read decrypts sensitive documents. One still in plain text is returned with a warning until EncryptDocuments has
+// finished the migration, and refused after that
+func (s *vaultStore) Read(name string) ([]byte, error) {
+	data, err := s.Store.Read(name)
+	if err != nil {
+		return nil, err
+	}
+	return s.decrypt(name, data)
+}
+
+func (s *vaultStore) Write(name string, data []byte) error {
+	sealed, err := s.encrypt(name, data)
+	if err != nil {
+		return err
+	}
+	return s.Store.Write(name, sealed)
+}
+
+func (s *vaultStore) Update(name string, fn func(data []byte) ([]byte, error)) error {
+	return s.Store.Update(name, func(data []byte) ([]byte, error) {
+		plain, err := s.decrypt(name, data)
+		if err != nil {
+			return nil, err
+		}
+
+		updated, err := fn(plain)
+		if err != nil {
+			return nil, err
+		}
+		return s.encrypt(name, updated)
+	})
+}
+
+// Path hides sensitive documents so nothing opens the encrypted file directly, editors get a decrypted copy instead
+func (s *vaultStore) Path(name string) (string, bool) {
+	if slices.Contains(Sensitive, name) {
+		return "", false
+	}
+	return s.Store.Path(name)
+}
+
+func (s *vaultStore) encrypt(name string, data []byte) ([]byte, error) {
+	if !slices.Contains(Sensitive, name) {
+		return data, nil
+	}
+	return s.seal(name, data)
+}
+
+func (s *vaultStore) decrypt(name string, data []byte) ([]byte, error) {
+	if !slices.Contains(Sensitive, name) || len(data) == 0 {
+		return data, nil
+	}
+
+	if !bytes.HasPrefix(data, []byte(vaultMagic)) {
+		if s.migrated.Load() {
+			return nil, fmt.Errorf("%s is in plain text but the vault is fully migrated, run vault encrypt if you restored it on purpose", name)
+		}
+		s.warnPlainText(name)
+		return data, nil
+	}
+
+	plain, err := s.open(name, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s: %w", name, err)
+	}
+	return plain, nil
+}
+
+func (s *vaultStore) warnPlainText(name string) {
+	s.warnedMu.Lock()
+	defer s.warnedMu.Unlock()
+	if s.warned[name] {
+		return
+	}
+	if s.warned == nil {
+		s.warned = make(map[string]bool)
+	}
+	s.warned[name] = true
+
+	report(fmt.Sprintf("%s Is Still In Plain Text, Run Encrypt Data (Or vault encrypt) To Finish Moving It Into The Vault", name))
+}
+
+// seal encrypts data under a fresh nonce, the document name is authenticated so files can't be swapped for each other
+func (s *vaultStore) seal(name string, data []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	sealed := append([]byte(vaultMagic), nonce...)
+	return s.aead.Seal(sealed, nonce, data, []byte(name)), nil
+}
+
+func (s *vaultStore) open(name string, data []byte) ([]byte, error) {
+	data = bytes.TrimPrefix(data, []byte(vaultMagic))
+	if len(data) < s.aead.NonceSize() {
+		return nil, fmt.Errorf("encrypted data is truncated")
+	}
+
+	nonce, sealed := data[:s.aead.NonceSize()], data[s.aead.NonceSize():]
+	return s.aead.Open(nil, nonce, sealed, []byte(name))
+}
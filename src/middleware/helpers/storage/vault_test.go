@@ -0,0 +1,150 @@
+package storage
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestVaultRoundTrip(t *testing.T) {
+	store := &fileStore{dir: t.TempDir()}
+	vault, err := createVault(store, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	documents := map[string]string{
+		ProfilesFile: "Profile Group Name,Profile Name\nMain,One\n",
+		TasksFile:    "Task Group\nDrop\n",
+	}
+	for name, data := range documents {
+		if err := vault.Write(name, []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	raw, err := store.Read(ProfilesFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(raw, []byte(vaultMagic)) || bytes.Contains(raw, []byte("Main,One")) {
+		t.Errorf("profiles.csv was stored in plain text: %q", raw)
+	}
+	if raw, _ := store.Read(TasksFile); string(raw) != documents[TasksFile] {
+		t.Errorf("tasks.csv is not sensitive but was stored as %q", raw)
+	}
+
+	meta, err := store.Read(VaultFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := openVault(store, meta, "wrong horse"); err == nil {
+		t.Error("openVault accepted the wrong passphrase")
+	}
+
+	reopened, err := openVault(store, meta, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range documents {
+		got, err := reopened.Read(name)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if string(got) != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+
+	// The document name is authenticated, an encrypted file can't stand in for another
+	if err := store.Write(AccountsFile, raw); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reopened.Read(AccountsFile); err == nil {
+		t.Error("profiles.csv decrypted as accounts.json")
+	}
+}
+
+func TestVaultRefusesPlainTextOnceMigrated(t *testing.T) {
+	var warnings []string
+	SetWarning(func(message string) { warnings = append(warnings, message) })
+	defer SetWarning(nil)
+
+	if err := Configure(t.TempDir(), ""); err != nil {
+		t.Fatal(err)
+	}
+
+	plain := `[{"name":"Main","accounts":["one@example.com:password"]}]`
+	if err := Write(AccountsFile, []byte(plain)); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := Current()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vault, err := createVault(store, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Before the migration finishes a plain text document is still read, with one warning
+	for range 2 {
+		got, err := vault.Read(AccountsFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != plain {
+			t.Errorf("accounts.json = %q, want %q", got, plain)
+		}
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], AccountsFile) {
+		t.Errorf("warnings = %q, want one for %s", warnings, AccountsFile)
+	}
+
+	// EncryptDocuments makes a vault the active store, encrypts what it finds and marks the vault migrated
+	encrypted, err := EncryptDocuments("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(encrypted) != 1 || encrypted[0] != AccountsFile {
+		t.Errorf("EncryptDocuments encrypted %v, want [%s]", encrypted, AccountsFile)
+	}
+
+	got, err := Read(AccountsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != plain {
+		t.Errorf("accounts.json = %q after encrypting, want %q", got, plain)
+	}
+
+	store, err = Current()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vault, ok := store.(*vaultStore)
+	if !ok {
+		t.Fatalf("active store is %T after EncryptDocuments, want the vault", store)
+	}
+
+	// A plain text copy restored after the migration is refused rather than trusted
+	if err := vault.Store.Write(AccountsFile, []byte(plain)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Read(AccountsFile); err == nil {
+		t.Error("plain text accounts.json was read after the vault was migrated")
+	}
+
+	meta, err := vault.Store.Read(VaultFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reopened, err := openVault(vault.Store, meta, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reopened.Read(AccountsFile); err == nil {
+		t.Error("plain text accounts.json was read after reopening the migrated vault")
+	}
+}
@@ -1,79 +1,169 @@
-package helpers
-
-type ColorizedLogger struct {
-	useColor bool
-}
-
-type Task struct {
-	TaskId        string
-	TaskGroupName string
-	Site          string
-	Mode          string
-	Input         string
-	Size          string
-	ProfileGroup  string
-	AccountGroup  string
-	ProxyGroup    string
-	Account       string
-	Payment       string
-	Quantity      int
-	Delay         int
-	Proxies       []string
-	Profile       Profile
-}
-
-type Profile struct {
-	ProfileName string
-	Email       string
-	Name        string
-	Phone       string
-	Address1    string
-	Address2    string
-	City        string
-	PostCode    string
-	Country     string
-	State       string
-	CardNumber  string
-	ExpMonth    string
-	ExpYear     string
-	CVV         string
-}
-
-// --------------------- WEBHOOK STRUCT --------------------- \\
-type PaypalWebhook struct {
-	CheckoutLink string
-	Account      string
-	Site         string
-	Mode         string
-	Product      string
-	Size         string
-	OrderNumber  string
-	Profile      string
-	ProxyGroup   string
-	Image        string
-}
-
-type Webhook struct {
-	Type        string
-	Account     string
-	Site        string
-	Mode        string
-	Product     string
-	Size        string
-	OrderNumber string
-	Profile     string
-	ProxyGroup  string
-	Image       string
-}
-
-// --------------------- SESSIONS STRUCT --------------------- \\
-type UserData struct {
-	AccessToken string
-	GID         int
-}
-
-type Session struct {
-	AccountEmail string `json:"accountEmail"`
-	AccessToken  string `json:"accessToken"`
-	GID          int    `json:"gid"`
-}
+package helpers
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+type ColorizedLogger struct {
+	useColor bool
+	mu       sync.Mutex
+	output   io.Writer
+}
+
+type Task struct {
+	TaskId        string
+	TaskGroupName string
+	Site          string
+	Mode          string
+	Items         []TaskItem
+	ProfileGroup  string
+	AccountGroup  string
+	ProxyGroup    string
+	Account       string
+	Payment       string
+	Shipping      string
+	DiscountCode  string
+	StepDelay     int
+	MaxPrice      int
+	MaxTotal      int
+	StartAt       time.Time
+	Retry         RetrySettings
+	Proxies       []string
+	Profile       Profile
+	Status        *TaskStatus
+}
+
+// TaskItem is one product line of a task's cart, every item is bought in the same order
+type TaskItem struct {
+	Input    string
+	Size     string
+	Quantity int
+}
+
+// Profile ships to Shipping and pays with the card's Billing address, see BillingAddress for when they're the same
+type Profile struct {
+	ProfileName string
+	Email       string
+	Phone       string
+	PhoneCode   string
+	Shipping    Address
+	Billing     Address
+	CardNumber  string
+	ExpMonth    string
+	ExpYear     string
+	CVV         string
+	Payment     string
+}
+
+type Address struct {
+	Name     string
+	Address1 string
+	Address2 string
+	City     string
+	PostCode string
+	Country  string
+	State    string
+}
+
+// ProfileColumn is one column profiles.csv can have, Names[0] is what the template writes and the rest are aliases
+type ProfileColumn struct {
+	Names    []string
+	Required bool
+	Set      func(profile *Profile, value string)
+}
+
+// --------------------- WEBHOOK STRUCT --------------------- \\
+type PaypalWebhook struct {
+	CheckoutLink string
+	Account      string
+	Site         string
+	Mode         string
+	Product      string
+	Size         string
+	Shipping     string
+	Discount     string
+	OrderNumber  string
+	Profile      string
+	ProxyGroup   string
+	Image        string
+}
+
+type Webhook struct {
+	Type        string
+	Account     string
+	Site        string
+	Mode        string
+	Product     string
+	Size        string
+	Shipping    string
+	Discount    string
+	OrderNumber string
+	Profile     string
+	ProxyGroup  string
+	Image       string
+}
+
+// --------------------- SESSIONS STRUCT --------------------- \\
+type UserData struct {
+	AccessToken string
+	GID         int
+	// Unverified is only set when a login response explicitly says is_verified false
+	Unverified bool
+}
+
+type Session struct {
+	AccountEmail string    `json:"accountEmail"`
+	AccessToken  string    `json:"accessToken"`
+	GID          int       `json:"gid"`
+	IssuedAt     time.Time `json:"issuedAt,omitzero"`
+	ExpiresAt    time.Time `json:"expiresAt,omitzero"`
+}
+
+// SessionStore caches sessions.json in memory and serializes every write to it
+type SessionStore struct {
+	mu       sync.Mutex
+	loaded   bool
+	sessions []Session
+}
+
+// --------------------- RETRY STRUCTS --------------------- \\
+// RetryPolicy controls how a step waits between attempts, unset fields fall back to the next policy up. The delays,
+// backoff and jitter are pointers because 0 is a real setting for them, no wait, no growth or no spread, while nil means unset
+type RetryPolicy struct {
+	MonitorDelay *int     `json:"monitorDelay,omitempty"` // ms between polls while a product is unavailable
+	ErrorDelay   *int     `json:"errorDelay,omitempty"`   // ms after the first failed request
+	MaxDelay     *int     `json:"maxDelay,omitempty"`     // ms cap once backoff has grown the error delay, 0 for no cap
+	Backoff      *float64 `json:"backoff,omitempty"`      // error delay multiplier for each consecutive failure, 1 or less keeps it flat
+	Jitter       *float64 `json:"jitter,omitempty"`       // random spread applied to every delay, 0.2 is ±20%
+	MaxAttempts  int      `json:"maxAttempts,omitempty"`  // failed requests a step may make before the task fails
+}
+
+type RetrySettings struct {
+	RetryPolicy
+	Steps map[TaskStep]RetryPolicy `json:"steps,omitempty"`
+}
+
+// --------------------- STATUS STRUCTS --------------------- \\
+type TaskStep string
+
+type TaskStatus struct {
+	TaskId        string
+	Account       string
+	Profile       string
+	Product       string // the cart and sizes picked, set once every item is in stock
+	Sku           string
+	Step          TaskStep
+	Attempts      map[TaskStep]int
+	LastError     string
+	CreatedAt     time.Time
+	StartedAt     time.Time
+	StepStartedAt time.Time
+	UpdatedAt     time.Time
+	FinishedAt    time.Time
+
+	cancel        context.CancelFunc
+	stopRequested bool
+}
@@ -0,0 +1,55 @@
+package desktop
+
+import (
+	"errors"
+	"regexp"
+	"sync"
+)
+
+const (
+	ApiBase   = "https://prod-na-api.popmart.com"
+	ClientKey = "nw3b089qrgw9m7b7i"
+)
+
+// Payment responses Popmart answers a declined card with, these end the task with a failure webhook instead of retrying
+var DeclinedMessages = []string{
+	"This transaction is risky. Please use another card or payment method.",
+	"The transaction was declined or flagged as risky. Please check with your bank.",
+}
+
+// Message fragments Popmart answers with when retrying can't help, matched case insensitively
+var (
+	InvalidAccountMessages  = []string{"password", "not exist", "not registered", "locked", "disabled"}
+	InvalidAddressMessages  = []string{"address", "postal", "zip", "phone", "province"}
+	InvalidDiscountMessages = []string{"discount code", "coupon"}
+	// Whole auth phrases only, a bare "token" would also catch unrelated failures like a rejected TD signature
+	SessionExpiredMessages = []string{
+		"token expired", "token has expired", "token is expired", "invalid token", "token invalid", "token is invalid",
+		"not logged in", "login expired", "please log in", "please login", "unauthorized",
+	}
+)
+
+// ErrSessionExpired is returned by any authorized request once Popmart rejects the token, the task logs in again and retries the step
+var ErrSessionExpired = errors.New("session expired")
+
+// maxRelogins caps how many times one task replaces an expired session before giving up
+const maxRelogins = 3
+
+var (
+	nonDigits  = regexp.MustCompile(`\D`)
+	productURL = regexp.MustCompile(`(?i)/products/(\d+)`)
+)
+
+// searchPageSize is how many search results keyword tasks check for a match on each poll
+const searchPageSize = 40
+
+// searchAttempts caps failed search requests, the search endpoint hasn't been checked against live traffic so a wrong
+// path or payload gives up quickly instead of retrying for the whole drop
+const searchAttempts = 3
+
+// monitors is keyed by spuId and searches by ProductQuery.Key, so one product written two ways still shares a poller
+var (
+	monitorsMu sync.Mutex
+	monitors   = make(map[string]*ProductMonitor)
+	searches   = make(map[string]*ProductSearch)
+)
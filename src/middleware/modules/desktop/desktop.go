@@ -1,157 +1,403 @@
-package desktop
-
-import (
-	"fmt"
-	"math/rand"
-	"strings"
-	"time"
-
-	helpers "popmart/src/middleware/helpers"
-	discord "popmart/src/middleware/helpers/discord"
-)
-
-func PopmartDesktop(task helpers.Task, logger *helpers.ColorizedLogger) {
-	logger.Info(fmt.Sprintf("Task %s: Starting Popmart %s Task", task.TaskId, task.Mode))
-	Desktop(task, logger)
-}
-
-func Desktop(task helpers.Task, logger *helpers.ColorizedLogger) {
-	if len(task.Proxies) == 0 {
-		logger.Error(fmt.Sprintf("Task %s: No Proxies Are Available", task.TaskId))
-		return
-	}
-
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
-	rawProxy := task.Proxies[rng.Intn(len(task.Proxies))]
-
-	parts := strings.Split(rawProxy, ":")
-	if len(parts) != 4 {
-		logger.Error(fmt.Sprintf("Task %s: Invalid Proxy Format: %s", task.TaskId, rawProxy))
-		return
-	}
-
-	ip, port, user, pass := parts[0], parts[1], parts[2], parts[3]
-	proxyURL := fmt.Sprintf("http://%s:%s@%s:%s", user, pass, ip, port)
-
-	logger.Verbose(fmt.Sprintf("Task %s: Using Proxy - %s", task.TaskId, proxyURL))
-	logger.Verbose(fmt.Sprintf("Task %s: Creating Request Client", task.TaskId))
-	client, err := helpers.CreateTLSClient(proxyURL)
-	if err != nil {
-		logger.Error(fmt.Sprintf("Task %s: Failed To Create Request Client: %v", task.TaskId, err))
-		return
-	}
-
-	accountParts := strings.SplitN(task.Account, ":", 2)
-	if len(accountParts) != 2 {
-		logger.Error(fmt.Sprintf("Task %s: Invalid Account Format: %s", task.TaskId, task.Account))
-		return
-	}
-
-	accountEmail := accountParts[0]
-	accountPassword := accountParts[1]
-	logger.Verbose(fmt.Sprintf("Task %s: Using Account - %s", task.TaskId, accountEmail))
-
-	var userData helpers.UserData
-	logger.Verbose(fmt.Sprintf("Task %s: Checking For Existing Session For %s", task.TaskId, accountEmail))
-
-	userData, err = helpers.FetchSession(logger, task.TaskId, accountEmail)
-	if err != nil {
-		logger.Warn(fmt.Sprintf("Task %s: No Session Was Found For %s, Logging In", task.TaskId, accountEmail))
-		checkErr := CheckExists(task, logger, client, accountEmail, proxyURL)
-		if checkErr != nil {
-			logger.Error(fmt.Sprintf("Task %s: Failed To Check Account Existence: %s", task.TaskId, task.Account))
-			return
-		}
-
-		helpers.Delay(task.Delay)
-		userData, err = Login(task, logger, client, accountEmail, accountPassword, proxyURL)
-		if err != nil {
-			logger.Error(fmt.Sprintf("Task %s: Failed To Log Into Popmart Account", task.TaskId))
-			return
-		}
-	}
-
-	helpers.Delay(task.Delay)
-	productDetails, err := FetchProduct(task, logger, client, proxyURL)
-	if err != nil {
-		logger.Error(fmt.Sprintf("Task %s: Failed To Fetch Product Details", task.TaskId))
-		return
-	}
-
-	helpers.Delay(task.Delay)
-	var customerAddress CustomerAddress
-	customerAddress, err = FetchAddress(task, logger, client, userData, proxyURL)
-	if err != nil {
-		customerAddress, err = AddAddress(task, logger, client, userData, proxyURL)
-		if err != nil {
-			logger.Error(fmt.Sprintf("Task %s: Failed To Submit Shipping Information", task.TaskId))
-			return
-		}
-	}
-
-	helpers.Delay(task.Delay)
-	atcErr := AddToCart(task, logger, client, userData, productDetails, proxyURL)
-	if atcErr != nil {
-		logger.Error(fmt.Sprintf("Task %s: Failed To Add Product To Cart", task.TaskId))
-		return
-	}
-
-	helpers.Delay(task.Delay)
-	shippingCost, err := FetchRates(task, logger, client, userData, productDetails, proxyURL)
-	if err != nil {
-		logger.Error(fmt.Sprintf("Task %s: Failed To Fetch Shipping Rates", task.TaskId))
-		return
-	}
-
-	helpers.Delay(task.Delay)
-	taxAmount, totalAmount, err := CalculateTaxes(task, logger, client, userData, productDetails, customerAddress, proxyURL)
-	if err != nil {
-		logger.Error(fmt.Sprintf("Task %s: Failed To Calculate Taxes", task.TaskId))
-		return
-	}
-
-	helpers.Delay(task.Delay)
-	orderDetails, err := CreateOrder(task, logger, client, userData, productDetails, customerAddress, proxyURL, shippingCost, taxAmount, totalAmount)
-	if err != nil {
-		logger.Error(fmt.Sprintf("Task %s: Failed To Create Popmart Order", task.TaskId))
-		return
-	}
-
-	var checkoutErr error
-
-	switch task.Payment {
-	case "Card":
-		helpers.Delay(task.Delay)
-		checkoutAttemptId, err := FetchCheckoutId(task, logger, client, proxyURL)
-		if err != nil {
-			logger.Error(fmt.Sprintf("Task %s: Failed To Fetch Checkout Attempt ID", task.TaskId))
-			return
-		}
-
-		helpers.Delay(task.Delay)
-		webhookData, err := ProcessPayment(task, logger, client, userData, orderDetails, accountEmail, proxyURL, checkoutAttemptId)
-		if err != nil {
-			logger.Error(fmt.Sprintf("Task %s: Failed To Process Payment", task.TaskId))
-			return
-		}
-
-		checkoutErr = discord.SendWebhook(logger, webhookData, task.TaskId)
-	case "Paypal":
-		helpers.Delay(task.Delay)
-		webhookData, err := Paypal(task, logger, client, userData, orderDetails, accountEmail, proxyURL)
-		if err != nil {
-			logger.Error(fmt.Sprintf("Task %s: Failed To Create Paypal Checkout Link", task.TaskId))
-			return
-		}
-
-		checkoutErr = discord.SendPaypal(logger, webhookData, task.TaskId)
-	default:
-		logger.Error(fmt.Sprintf("Task %s: Unsupported Payment Type Selected", task.TaskId))
-		return
-	}
-
-	if checkoutErr != nil {
-		logger.Error(fmt.Sprintf("Task %s: Failed To Process Popmart Order", task.TaskId))
-		return
-	}
-}
+package desktop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	helpers "popmart/src/middleware/helpers"
+	discord "popmart/src/middleware/helpers/discord"
+
+	tls_client "github.com/bogdanfinn/tls-client"
+)
+
+func PopmartDesktop(ctx context.Context, task helpers.Task, logger *helpers.ColorizedLogger) error {
+	logger.Info(fmt.Sprintf("Task %s: Starting Popmart %s Task", task.TaskId, task.Mode))
+	err := Desktop(ctx, task, logger)
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		logger.Warn(fmt.Sprintf("Task %s: Task Has Been Stopped", task.TaskId))
+	}
+	return err
+}
+
+func Desktop(ctx context.Context, task helpers.Task, logger *helpers.ColorizedLogger) error {
+	c, err := newCheckout(task, logger)
+	if err != nil {
+		return err
+	}
+	defer c.leaveMonitors()
+
+	steps := map[helpers.TaskStep]func(context.Context) (helpers.TaskStep, error){
+		helpers.StepLogin:        c.login,
+		helpers.StepAddress:      c.address,
+		helpers.StepWaiting:      c.waitForRelease,
+		helpers.StepFetchProduct: c.fetchProduct,
+		helpers.StepATC:          c.addToCart,
+		helpers.StepRates:        c.rates,
+		helpers.StepTaxes:        c.taxes,
+		helpers.StepCreateOrder:  c.createOrder,
+		helpers.StepPayment:      c.payment,
+	}
+
+	step := helpers.CheckoutSteps[0]
+	relogins := 0
+	for step != helpers.StepDone {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if step != helpers.CheckoutSteps[0] {
+			helpers.Delay(ctx, task.StepDelay)
+		}
+
+		task.Status.Enter(step)
+		next, err := steps[step](ctx)
+		if errors.Is(err, ErrSessionExpired) && relogins < maxRelogins {
+			relogins++
+			logger.Warn(fmt.Sprintf("Task %s: Session Expired During %s, Logging In Again [%d/%d]", task.TaskId, step, relogins, maxRelogins))
+			if err := c.logIn(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		step = next
+	}
+	return nil
+}
+
+// WarmSession makes sure an account has a working saved session before a drop, reporting whether the saved one was reused
+// and whether a fresh login came back explicitly marked unverified
+func WarmSession(ctx context.Context, task helpers.Task, logger *helpers.ColorizedLogger) (reused, unverified bool, err error) {
+	c, err := newCheckout(task, logger)
+	if err != nil {
+		return false, false, err
+	}
+
+	if userData, err := helpers.FetchSession(logger, task.TaskId, c.accountEmail); err == nil {
+		valid, err := c.checkSession(ctx, userData)
+		if err != nil {
+			return false, false, err
+		}
+		if valid {
+			return true, false, nil
+		}
+	}
+
+	if err := c.logIn(ctx); err != nil {
+		return false, false, err
+	}
+	return false, c.userData.Unverified, nil
+}
+
+// newCheckout picks one of the task's proxies and builds the client and account details every step shares
+func newCheckout(task helpers.Task, logger *helpers.ColorizedLogger) (*checkout, error) {
+	if len(task.Proxies) == 0 {
+		logger.Error(fmt.Sprintf("Task %s: No Proxies Are Available", task.TaskId))
+		return nil, fmt.Errorf("no proxies available")
+	}
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rawProxy := task.Proxies[rng.Intn(len(task.Proxies))]
+
+	proxyURL, err := ProxyURL(rawProxy)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Task %s: Invalid Proxy Format: %s", task.TaskId, rawProxy))
+		return nil, err
+	}
+
+	logger.Verbose(fmt.Sprintf("Task %s: Using Proxy - %s", task.TaskId, proxyURL))
+	logger.Verbose(fmt.Sprintf("Task %s: Creating Request Client", task.TaskId))
+	client, err := helpers.CreateTLSClient(proxyURL)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Task %s: Failed To Create Request Client: %v", task.TaskId, err))
+		return nil, err
+	}
+
+	accountParts := strings.SplitN(task.Account, ":", 2)
+	if len(accountParts) != 2 {
+		logger.Error(fmt.Sprintf("Task %s: Invalid Account Format: %s", task.TaskId, task.Account))
+		return nil, fmt.Errorf("invalid account format")
+	}
+
+	logger.Verbose(fmt.Sprintf("Task %s: Using Account - %s", task.TaskId, accountParts[0]))
+	return &checkout{
+		task:            task,
+		logger:          logger,
+		client:          client,
+		proxyURL:        proxyURL,
+		accountEmail:    accountParts[0],
+		accountPassword: accountParts[1],
+	}, nil
+}
+
+// --------------- CHECKOUT STEPS --------------- \\
+// Each step returns the step to run next, carrying its results forward on the checkout
+type checkout struct {
+	task            helpers.Task
+	logger          *helpers.ColorizedLogger
+	client          tls_client.HttpClient
+	proxyURL        string
+	accountEmail    string
+	accountPassword string
+
+	userData helpers.UserData
+	watched  []*ProductMonitor
+	release  func()
+	products []ProductDetails
+	customer CustomerAddress
+	shipping ShippingOption
+	discount Discount
+	amounts  TaxData
+	order    OrderDetails
+}
+
+func (c *checkout) login(ctx context.Context) (helpers.TaskStep, error) {
+	task, logger := c.task, c.logger
+	logger.Verbose(fmt.Sprintf("Task %s: Checking For Existing Session For %s", task.TaskId, c.accountEmail))
+
+	userData, err := helpers.FetchSession(logger, task.TaskId, c.accountEmail)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("Task %s: No Valid Session Was Found For %s, Logging In", task.TaskId, c.accountEmail))
+		return helpers.StepAddress, c.logIn(ctx)
+	}
+
+	valid, err := c.checkSession(ctx, userData)
+	if err != nil {
+		return "", err
+	}
+	if !valid {
+		logger.Warn(fmt.Sprintf("Task %s: Saved Session For %s Has Expired, Logging In", task.TaskId, c.accountEmail))
+		return helpers.StepAddress, c.logIn(ctx)
+	}
+
+	c.userData = userData
+	return helpers.StepAddress, nil
+}
+
+// checkSession reports whether a saved session is still good, only an expired session is treated as bad.
+// Any other validation failure falls back to the token's own expiry, which FetchSession has already checked
+func (c *checkout) checkSession(ctx context.Context, userData helpers.UserData) (bool, error) {
+	err := ValidateSession(ctx, c.task, c.logger, c.client, userData, c.proxyURL)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, ErrSessionExpired):
+		return false, nil
+	case ctx.Err() != nil:
+		return false, ctx.Err()
+	default:
+		c.logger.Warn(fmt.Sprintf("Task %s: Could Not Validate Session For %s [%s], Using Saved Session", c.task.TaskId, c.accountEmail, err.Error()))
+		return true, nil
+	}
+}
+
+// logIn signs in with the account's credentials, Login saves the new session for later tasks
+func (c *checkout) logIn(ctx context.Context) error {
+	task, logger := c.task, c.logger
+	if err := CheckExists(ctx, task, logger, c.client, c.accountEmail, c.proxyURL); err != nil {
+		logger.Error(fmt.Sprintf("Task %s: Failed To Check Account Existence: %s", task.TaskId, task.Account))
+		return err
+	}
+
+	helpers.Delay(ctx, task.StepDelay)
+	userData, err := Login(ctx, task, logger, c.client, c.accountEmail, c.accountPassword, c.proxyURL)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Task %s: Failed To Log Into Popmart Account", task.TaskId))
+		return err
+	}
+
+	c.userData = userData
+	return nil
+}
+
+func (c *checkout) address(ctx context.Context) (helpers.TaskStep, error) {
+	customerAddress, err := FetchAddress(ctx, c.task, c.logger, c.client, c.userData, c.proxyURL)
+	if err != nil {
+		customerAddress, err = AddAddress(ctx, c.task, c.logger, c.client, c.userData, c.proxyURL)
+		if err != nil {
+			c.logger.Error(fmt.Sprintf("Task %s: Failed To Submit Shipping Information", c.task.TaskId))
+			return "", err
+		}
+	}
+
+	c.customer = customerAddress
+	return helpers.StepWaiting, nil
+}
+
+// waitForRelease idles a pre-warmed task until its scheduled start, unscheduled tasks go straight through
+func (c *checkout) waitForRelease(ctx context.Context) (helpers.TaskStep, error) {
+	if wait := time.Until(c.task.StartAt); wait > 0 {
+		message := fmt.Sprintf("Task %s: Session Ready, Waiting For Release At %s", c.task.TaskId, c.task.StartAt.Format("15:04:05"))
+		c.logger.Info(message)
+		c.task.Status.Note(message)
+		helpers.Wait(ctx, wait)
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		c.logger.Info(fmt.Sprintf("Task %s: Release Time Reached, Starting Checkout", c.task.TaskId))
+	}
+	return helpers.StepFetchProduct, nil
+}
+
+// fetchProduct waits until every item in the task's cart has a size in stock, joining the product monitors the first time
+func (c *checkout) fetchProduct(ctx context.Context) (helpers.TaskStep, error) {
+	if c.watched == nil {
+		watched, release, err := WatchProducts(ctx, c.task, c.logger)
+		if err != nil {
+			c.logger.Error(fmt.Sprintf("Task %s: Failed To Fetch Product Details", c.task.TaskId))
+			return "", err
+		}
+		c.watched, c.release = watched, release
+	}
+
+	products, err := WaitForProducts(ctx, c.task, c.logger, c.watched)
+	if err != nil {
+		c.logger.Error(fmt.Sprintf("Task %s: Failed To Fetch Product Details", c.task.TaskId))
+		return "", err
+	}
+
+	if err := c.selectProducts(products); err != nil {
+		return "", err
+	}
+	return helpers.StepATC, nil
+}
+
+// selectProducts makes products the cart, checking them against Max Price
+func (c *checkout) selectProducts(products []ProductDetails) error {
+	c.products = products
+	c.task.Status.Select(CartSummary(c.products))
+
+	if err := CheckMaxPrice(c.task, c.products); err != nil {
+		c.logger.Error(fmt.Sprintf("Task %s: Price Guard Tripped [%s], Stopping Task", c.task.TaskId, err.Error()))
+		return err
+	}
+	return nil
+}
+
+// leaveMonitors lets the product monitors stop once no other task is waiting on them
+func (c *checkout) leaveMonitors() {
+	if c.release != nil {
+		c.release()
+	}
+}
+
+func (c *checkout) addToCart(ctx context.Context) (helpers.TaskStep, error) {
+	// Stock can sell out between the wait and the cart, so the latest polls are checked once more right before carting
+	products, _, err := SnapshotProducts(c.task.Items, c.watched)
+	var waiting *monitorError
+	if errors.As(err, &waiting) {
+		c.logger.Warn(fmt.Sprintf("Task %s: Product Went Out Of Stock Before Cart [%s], Waiting Again", c.task.TaskId, waiting.Error()))
+		return helpers.StepFetchProduct, nil
+	}
+	if err != nil {
+		c.logger.Error(fmt.Sprintf("Task %s: Product Monitor Stopped [%s]", c.task.TaskId, err.Error()))
+		return "", err
+	}
+	if err := c.selectProducts(products); err != nil {
+		return "", err
+	}
+
+	for i, product := range c.products {
+		if i > 0 {
+			helpers.Delay(ctx, c.task.StepDelay)
+		}
+
+		if err := AddToCart(ctx, c.task, c.logger, c.client, c.userData, product, c.proxyURL); err != nil {
+			c.logger.Error(fmt.Sprintf("Task %s: Failed To Add Product To Cart", c.task.TaskId))
+			return "", err
+		}
+	}
+	return helpers.StepRates, nil
+}
+
+func (c *checkout) rates(ctx context.Context) (helpers.TaskStep, error) {
+	c.discount = Discount{Code: c.task.DiscountCode}
+	shipping, err := FetchRates(ctx, c.task, c.logger, c.client, c.userData, c.products, c.discount, c.proxyURL)
+	if err != nil {
+		c.logger.Error(fmt.Sprintf("Task %s: Failed To Fetch Shipping Rates", c.task.TaskId))
+		return "", err
+	}
+
+	c.shipping = shipping
+	return helpers.StepTaxes, nil
+}
+
+func (c *checkout) taxes(ctx context.Context) (helpers.TaskStep, error) {
+	taxes, err := CalculateTaxes(ctx, c.task, c.logger, c.client, c.userData, c.products, c.customer, c.discount, c.proxyURL)
+	if err != nil {
+		c.logger.Error(fmt.Sprintf("Task %s: Failed To Calculate Taxes", c.task.TaskId))
+		return "", err
+	}
+
+	c.amounts = taxes
+	if err := CheckMaxTotal(c.task, taxes.TotalAmount+taxes.TaxAmount+c.shipping.Price); err != nil {
+		c.logger.Error(fmt.Sprintf("Task %s: Price Guard Tripped [%s], Stopping Task", c.task.TaskId, err.Error()))
+		return "", err
+	}
+	return helpers.StepCreateOrder, nil
+}
+
+func (c *checkout) createOrder(ctx context.Context) (helpers.TaskStep, error) {
+	order, err := CreateOrder(ctx, c.task, c.logger, c.client, c.userData, c.products, c.customer, c.proxyURL, c.shipping, c.discount, c.amounts)
+	if err != nil {
+		c.logger.Error(fmt.Sprintf("Task %s: Failed To Create Popmart Order", c.task.TaskId))
+		return "", err
+	}
+
+	c.order = order
+	return helpers.StepPayment, nil
+}
+
+func (c *checkout) payment(ctx context.Context) (helpers.TaskStep, error) {
+	task, logger := c.task, c.logger
+	var checkoutErr, resultErr error
+
+	// Popmart's own order amount is what gets charged, so it's checked again before paying
+	if err := CheckMaxTotal(task, int(c.order.TotalAmount)); err != nil {
+		logger.Error(fmt.Sprintf("Task %s: Price Guard Tripped [%s], Stopping Task", task.TaskId, err.Error()))
+		return "", err
+	}
+
+	switch task.Payment {
+	case "Card":
+		checkoutAttemptId, err := FetchCheckoutId(ctx, task, logger, c.client, c.proxyURL)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Task %s: Failed To Fetch Checkout Attempt ID", task.TaskId))
+			return "", err
+		}
+
+		helpers.Delay(ctx, task.StepDelay)
+		webhookData, err := ProcessPayment(ctx, task, logger, c.client, c.userData, c.order, c.accountEmail, c.proxyURL, checkoutAttemptId)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Task %s: Failed To Process Payment", task.TaskId))
+			return "", err
+		}
+
+		checkoutErr = discord.SendWebhook(logger, webhookData, task.TaskId)
+		if webhookData.Type != "Success" {
+			resultErr = fmt.Errorf("payment declined")
+		}
+	case "Paypal":
+		webhookData, err := Paypal(ctx, task, logger, c.client, c.userData, c.order, c.accountEmail, c.proxyURL)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Task %s: Failed To Create Paypal Checkout Link", task.TaskId))
+			return "", err
+		}
+
+		checkoutErr = discord.SendPaypal(logger, webhookData, task.TaskId)
+	default:
+		logger.Error(fmt.Sprintf("Task %s: Unsupported Payment Type Selected", task.TaskId))
+		return "", fmt.Errorf("unsupported payment type: %s", task.Payment)
+	}
+
+	if checkoutErr != nil {
+		logger.Error(fmt.Sprintf("Task %s: Failed To Process Popmart Order", task.TaskId))
+	}
+	return helpers.StepDone, resultErr
+}
@@ -0,0 +1,322 @@
+package desktop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+
+	helpers "popmart/src/middleware/helpers"
+)
+
+// WatchProducts resolves every item's Input and joins the shared monitor for its product, release leaves them all
+func WatchProducts(ctx context.Context, task helpers.Task, logger *helpers.ColorizedLogger) ([]*ProductMonitor, func(), error) {
+	var watched []*ProductMonitor
+	var releases []func()
+	release := func() {
+		for _, r := range releases {
+			r()
+		}
+	}
+
+	for _, item := range task.Items {
+		spuId, err := resolveShared(ctx, task, logger, item.Input)
+		if err != nil {
+			release()
+			return nil, nil, err
+		}
+
+		monitor, r := watchProduct(task, logger, spuId)
+		watched = append(watched, monitor)
+		releases = append(releases, r)
+	}
+	return watched, release, nil
+}
+
+// WaitForProducts blocks until one snapshot of the monitors has a size in stock for every item, so an item that sold
+// out while another was still waiting is caught instead of carted blind
+func WaitForProducts(ctx context.Context, task helpers.Task, logger *helpers.ColorizedLogger, watched []*ProductMonitor) ([]ProductDetails, error) {
+	logger.Verbose(fmt.Sprintf("Task %s: Waiting On Product Monitors [%d Items]", task.TaskId, len(watched)))
+	waiting := ""
+	for {
+		products, updated, err := SnapshotProducts(task.Items, watched)
+		if err == nil {
+			message := fmt.Sprintf("Task %s: Products Available [%d Items]", task.TaskId, len(products))
+			logger.Verbose(message)
+			task.Status.Note(message)
+			return products, nil
+		}
+
+		var stopped *monitorError
+		if !errors.As(err, &stopped) {
+			logger.Error(fmt.Sprintf("Task %s: Product Monitor Stopped [%s]", task.TaskId, err.Error()))
+			return nil, err
+		}
+
+		if stopped.Error() != waiting {
+			waiting = stopped.Error()
+			message := fmt.Sprintf("Task %s: Waiting For Product [%s]", task.TaskId, waiting)
+			logger.Warn(message)
+			task.Status.Note(message)
+		}
+
+		// Wake on whichever monitor polls next
+		cases := []reflect.SelectCase{{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())}}
+		for _, ch := range updated {
+			cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)})
+		}
+		if chosen, _, _ := reflect.Select(cases); chosen == 0 {
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// SnapshotProducts picks every item's size from the monitors' latest polls. An item that can't be bought yet comes back
+// wrapped by Monitor, a monitor that failed for good comes back as is. updated holds each monitor's next poll channel
+func SnapshotProducts(items []helpers.TaskItem, watched []*ProductMonitor) ([]ProductDetails, []chan struct{}, error) {
+	products := make([]ProductDetails, 0, len(items))
+	updated := make([]chan struct{}, len(watched))
+	var waiting error
+
+	for i, monitor := range watched {
+		monitor.mu.Lock()
+		product, polled, err := monitor.product, monitor.polled, monitor.err
+		updated[i] = monitor.updated
+		monitor.mu.Unlock()
+
+		switch {
+		case err != nil:
+			return nil, updated, err
+		case !polled:
+			if waiting == nil {
+				waiting = fmt.Errorf("Not Polled Yet")
+			}
+			continue
+		}
+
+		productDetails, err := SelectSku(items[i], product)
+		if err != nil {
+			if waiting == nil {
+				waiting = err
+			}
+			continue
+		}
+		products = append(products, productDetails)
+	}
+
+	if waiting != nil {
+		return nil, updated, Monitor(waiting)
+	}
+	return products, updated, nil
+}
+
+// resolveShared turns an Input into a spuId, tasks with the same keywords wait on one search instead of each running their own
+func resolveShared(ctx context.Context, task helpers.Task, logger *helpers.ColorizedLogger, input string) (string, error) {
+	query, err := ParseInput(input)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Task %s: Invalid Product Input [%s]", task.TaskId, input))
+		return "", err
+	}
+	if query.SpuId != "" {
+		return query.SpuId, nil
+	}
+
+	monitorsMu.Lock()
+	search, ok := searches[query.Key()]
+	if !ok {
+		searchCtx, cancel := context.WithCancel(context.Background())
+		search = &ProductSearch{
+			key:    query.Key(),
+			cancel: cancel,
+			done:   make(chan struct{}),
+		}
+		searches[search.key] = search
+
+		// Like the monitor, the search polls on its own client from the group's proxies rather than the starting task's
+		searchTask := task
+		searchTask.TaskId = "Search-" + input
+		searchTask.Status = nil
+		go func() {
+			poller, err := newPoller(searchTask.Proxies)
+			if err == nil {
+				search.spuId, err = ResolveProduct(searchCtx, searchTask, logger, poller.client, input, poller.proxyURL)
+			}
+			search.err = err
+
+			// A finished search is forgotten so tasks that come later look again rather than reuse an old result
+			monitorsMu.Lock()
+			if searches[search.key] == search {
+				delete(searches, search.key)
+			}
+			monitorsMu.Unlock()
+			close(search.done)
+		}()
+	}
+	search.refs++
+	monitorsMu.Unlock()
+
+	defer func() {
+		monitorsMu.Lock()
+		defer monitorsMu.Unlock()
+
+		search.refs--
+		if search.refs == 0 {
+			search.cancel()
+			if searches[search.key] == search {
+				delete(searches, search.key)
+			}
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case <-search.done:
+		return search.spuId, search.err
+	}
+}
+
+// watchProduct joins the monitor for a spuId, starting one on the task's proxy group and retry policy if none is running
+func watchProduct(task helpers.Task, logger *helpers.ColorizedLogger, spuId string) (*ProductMonitor, func()) {
+	monitorsMu.Lock()
+	defer monitorsMu.Unlock()
+
+	monitor, ok := monitors[spuId]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		monitor = &ProductMonitor{
+			spuId:   spuId,
+			cancel:  cancel,
+			updated: make(chan struct{}),
+		}
+		monitors[spuId] = monitor
+
+		// The monitor outlives any one task, so it runs as its own untracked task
+		monitorTask := task
+		monitorTask.TaskId = "Monitor-" + spuId
+		monitorTask.Status = nil
+		go monitor.run(ctx, monitorTask, logger)
+	}
+	monitor.refs++
+
+	return monitor, func() {
+		monitorsMu.Lock()
+		defer monitorsMu.Unlock()
+
+		monitor.refs--
+		if monitor.refs == 0 {
+			monitor.cancel()
+			monitor.forget()
+		}
+	}
+}
+
+// run polls the product one attempt at a time, a failed poll moves the monitor onto the next proxy in the pool and only
+// running out of attempts in a row stops it
+func (m *ProductMonitor) run(ctx context.Context, task helpers.Task, logger *helpers.ColorizedLogger) {
+	logger.Info(fmt.Sprintf("Task %s: Starting Product Monitor", task.TaskId))
+	policy := task.Retry.For(helpers.StepFetchProduct)
+	task.Retry = task.Retry.Override(helpers.RetryPolicy{MaxAttempts: 1, ErrorDelay: helpers.Millis(0)})
+
+	poller, err := newPoller(task.Proxies)
+	if err != nil {
+		m.stop(err)
+		return
+	}
+
+	inStock, failures := -1, 0
+	for {
+		product, err := FetchProduct(ctx, task, logger, poller.client, m.spuId, poller.proxyURL)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			failures++
+			if failures >= policy.MaxAttempts {
+				m.stop(err)
+				return
+			}
+
+			logger.Warn(fmt.Sprintf("Task %s: Product Monitor Failed, Rotating Proxy [%d/%d]", task.TaskId, failures, policy.MaxAttempts))
+			if err := poller.rotate(); err != nil {
+				m.stop(err)
+				return
+			}
+			helpers.Wait(ctx, policy.ErrorWait(failures))
+			continue
+		}
+		failures = 0
+
+		available := 0
+		for _, sku := range product.Skus {
+			if sku.Stock.OnlineStock > 0 {
+				available++
+			}
+		}
+		if available != inStock {
+			inStock = available
+			logger.Info(fmt.Sprintf("Task %s: %d Of %d Sizes In Stock", task.TaskId, available, len(product.Skus)))
+		}
+
+		m.publish(product, nil)
+		helpers.Wait(ctx, policy.MonitorWait())
+	}
+}
+
+// stop publishes the error that ended the monitor, later tasks start a fresh monitor instead of getting it straight away
+func (m *ProductMonitor) stop(err error) {
+	monitorsMu.Lock()
+	m.forget()
+	monitorsMu.Unlock()
+	m.publish(ProductData{}, err)
+}
+
+func (m *ProductMonitor) publish(product ProductData, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.product, m.polled, m.err = product, true, err
+	close(m.updated)
+	m.updated = make(chan struct{})
+}
+
+// forget takes the monitor out of the map unless a newer one already replaced it, monitorsMu must be held
+func (m *ProductMonitor) forget() {
+	if monitors[m.spuId] == m {
+		delete(monitors, m.spuId)
+	}
+}
+
+// newPoller builds a shared poller's client on a random proxy from the pool
+func newPoller(proxies []string) (*poller, error) {
+	if len(proxies) == 0 {
+		return nil, fmt.Errorf("no proxies available")
+	}
+
+	p := &poller{proxies: slices.Clone(proxies), next: rand.Intn(len(proxies))}
+	return p, p.rotate()
+}
+
+// rotate moves the poller onto the pool's next proxy, skipping any that can't be used
+func (p *poller) rotate() error {
+	for range p.proxies {
+		raw := p.proxies[p.next%len(p.proxies)]
+		p.next++
+
+		proxyURL, err := ProxyURL(raw)
+		if err != nil {
+			continue
+		}
+		client, err := helpers.CreateTLSClient(proxyURL)
+		if err != nil {
+			continue
+		}
+
+		p.client, p.proxyURL = client, proxyURL
+		return nil
+	}
+	return fmt.Errorf("no usable proxies")
+}
@@ -0,0 +1,201 @@
+package desktop
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	helpers "popmart/src/middleware/helpers"
+	api "popmart/src/middleware/helpers/api"
+
+	http "github.com/bogdanfinn/fhttp"
+	tls_client "github.com/bogdanfinn/tls-client"
+)
+
+// Execute sends an endpoint until its Success check passes, retrying failures under the task's policy for the endpoint's step
+func Execute[T any](ctx context.Context, task helpers.Task, logger *helpers.ColorizedLogger, client tls_client.HttpClient, proxyUrl string, endpoint Endpoint[T]) error {
+	policy := task.Retry.For(endpoint.Step)
+	failures := 0
+	fail := func(message string) {
+		failures++
+		retry(ctx, task, logger, fmt.Sprintf("Task %s: %s, Retrying [%d/%d]", task.TaskId, message, failures, policy.MaxAttempts), policy.ErrorWait(failures))
+	}
+
+	for failures < policy.MaxAttempts {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		req, failure, err := newRequest(ctx, task, logger, proxyUrl, endpoint)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			fail(failure)
+			continue
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			fail("Failed To Execute Request")
+			continue
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			fail("Failed To Read Response Body")
+			continue
+		}
+
+		if endpoint.Token != "" && resp.StatusCode == http.StatusUnauthorized {
+			logger.Warn(fmt.Sprintf("Task %s: Session Expired While %s", task.TaskId, endpoint.Name))
+			return ErrSessionExpired
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			fail(fmt.Sprintf("Error %s [%d]", endpoint.Name, resp.StatusCode))
+			continue
+		}
+
+		var result T
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			fail("Failed To Unmarshal JSON Response Body")
+			continue
+		}
+
+		if endpoint.URL == "" {
+			var env envelope
+			if err := json.Unmarshal(respBody, &env); err != nil {
+				fail("Failed To Unmarshal JSON Response Body")
+				continue
+			}
+
+			if env.Message != "success" && !slices.Contains(endpoint.Accept, env.Message) {
+				if endpoint.Token != "" && matchesAny(env.Message, SessionExpiredMessages) {
+					logger.Warn(fmt.Sprintf("Task %s: Session Expired While %s [%s]", task.TaskId, endpoint.Name, env.Message))
+					return ErrSessionExpired
+				}
+
+				if matchesAny(env.Message, endpoint.Fatal) {
+					logger.Error(fmt.Sprintf("Task %s: Error %s [%s], Stopping Task", task.TaskId, endpoint.Name, env.Message))
+					return fmt.Errorf("%s", env.Message)
+				}
+
+				fail(fmt.Sprintf("Error %s [%s]", endpoint.Name, env.Message))
+				continue
+			}
+		}
+
+		if endpoint.Success == nil {
+			return nil
+		}
+
+		err = endpoint.Success(result)
+		var fatal *fatalError
+		var monitor *monitorError
+		switch {
+		case err == nil:
+			return nil
+		case errors.As(err, &fatal):
+			return fatal.err
+		case errors.As(err, &monitor):
+			// The request itself worked, so backoff starts over once the product shows up
+			failures = 0
+			message := fmt.Sprintf("Task %s: %s [%s], Monitoring", task.TaskId, endpoint.Name, monitor.err.Error())
+			logger.Warn(message)
+			task.Status.Retry(message)
+			helpers.Wait(ctx, policy.MonitorWait())
+		default:
+			fail(fmt.Sprintf("Error %s [%s]", endpoint.Name, err.Error()))
+		}
+	}
+
+	logger.Error(fmt.Sprintf("Task %s: Max Retries Has Been Reached", task.TaskId))
+	return fmt.Errorf("maximum retries reached")
+}
+
+// Fatal marks an error returned from a Success check as final so Execute stops retrying
+func Fatal(err error) error {
+	return &fatalError{err: err}
+}
+
+// Monitor marks an error returned from a Success check as a product that isn't available yet, polled at the monitor delay
+func Monitor(err error) error {
+	return &monitorError{err: err}
+}
+
+func (e *fatalError) Error() string {
+	return e.err.Error()
+}
+
+func (e *fatalError) Unwrap() error {
+	return e.err
+}
+
+func (e *monitorError) Error() string {
+	return e.err.Error()
+}
+
+func (e *monitorError) Unwrap() error {
+	return e.err
+}
+
+// newRequest builds the request for one attempt, on error it also returns the Title Case failure to log
+func newRequest[T any](ctx context.Context, task helpers.Task, logger *helpers.ColorizedLogger, proxyUrl string, endpoint Endpoint[T]) (*http.Request, string, error) {
+	if endpoint.URL != "" {
+		jsonPayload, err := MarshalOrderedMap(endpoint.Payload)
+		if err != nil {
+			return nil, "Failed To Marshal Request Payload", err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, endpoint.Method, endpoint.URL, strings.NewReader(string(jsonPayload)))
+		if err != nil {
+			return nil, "Failed To Create Request", err
+		}
+		req.Header = endpoint.Headers.Clone()
+		return req, "", nil
+	}
+
+	data, err := MarshalOrderedMap(endpoint.Payload)
+	if err != nil {
+		return nil, "Failed To Marshal API Payload", err
+	}
+
+	// Popmart signs every request as a post, GETs included
+	tdResp, err := api.TD(ctx, logger, data, task.TaskId, endpoint.Path, proxyUrl, "post", helpers.UserAgent)
+	if err != nil {
+		return nil, "Failed To Generate TD Parameters", err
+	}
+
+	url := ApiBase + "/" + strings.TrimPrefix(endpoint.Path, "/")
+	var body io.Reader
+	if endpoint.Method == "GET" {
+		query := fmt.Sprintf("s=%s&t=%d", tdResp.S, tdResp.T)
+		if endpoint.Query != "" {
+			query = endpoint.Query + "&" + query
+		}
+		url += "?" + query
+	} else {
+		signed := append(slices.Clone(endpoint.Payload), OrderedKV{"s", tdResp.S}, OrderedKV{"t", int64(tdResp.T)})
+		jsonPayload, err := MarshalOrderedMap(signed)
+		if err != nil {
+			return nil, "Failed To Marshal Request Payload", err
+		}
+		body = strings.NewReader(string(jsonPayload))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, endpoint.Method, url, body)
+	if err != nil {
+		return nil, "Failed To Create Request", err
+	}
+	req.Header = PopmartHeaders(tdResp, endpoint.Path, endpoint.Token)
+	return req, "", nil
+}
@@ -1,81 +1,452 @@
-package desktop
-
-import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"popmart/src/middleware/helpers"
-	"popmart/src/middleware/helpers/adyen"
-)
-
-func (om OrderedMap) MarshalJSON() ([]byte, error) {
-	return MarshalOrderedMap([]OrderedKV(om))
-}
-
-func MarshalOrderedMap(pairs []OrderedKV) (json.RawMessage, error) {
-	var buf bytes.Buffer
-	buf.WriteByte('{')
-	for i, pair := range pairs {
-		keyJSON, _ := json.Marshal(pair.Key)
-		valJSON, _ := json.Marshal(pair.Value)
-
-		buf.Write(keyJSON)
-		buf.WriteByte(':')
-		buf.Write(valJSON)
-
-		if i < len(pairs)-1 {
-			buf.WriteByte(',')
-		}
-	}
-	buf.WriteByte('}')
-	return json.RawMessage(buf.Bytes()), nil
-}
-
-func AdyenHelper(logger *helpers.ColorizedLogger, task helpers.Task, order OrderDetails, checkoutAttemptId string) (string, error) {
-	adyenData, err := adyen.AdyenEncrypt(logger, task.TaskId, task.Profile.CardNumber, task.Profile.ExpMonth, task.Profile.ExpYear, task.Profile.CVV)
-	if err != nil {
-		logger.Error(fmt.Sprintf("Task %s: Failed To Adyen Encrypt Payment", task.TaskId))
-		return "", err
-	}
-
-	adyenPayload := AdyenData{
-		PaymentMethod: AdyenPayment{
-			Type:              "scheme",
-			HolderName:        task.Profile.Name,
-			CardNumber:        adyenData.CardNumber,
-			ExpiryMonth:       adyenData.ExpiryMonth,
-			ExpiryYear:        adyenData.ExpiryYear,
-			SecurityCode:      adyenData.SecurityCode,
-			CardBrand:         adyenData.CardType,
-			CheckoutAttemptId: checkoutAttemptId,
-		},
-		BrowserInfo: AdyenBrowser{
-			TimezoneOffset:    240,
-			AcceptHeader:      "*/*",
-			JavascriptEnabled: true,
-			Language:          "nl-NL",
-			JavaEnabled:       true,
-			ScreenHeight:      723,
-			ScreenWidth:       1536,
-			ColorDepth:        24,
-			UserAgent:         helpers.UserAgent,
-		},
-		StorePayment: false,
-		Risk: RiskData{
-			ClientData: adyenData.RiskData,
-		},
-		AdditionalData: Additional{
-			Allow3DS2: "true",
-		},
-		Channel:   "Web",
-		Origin:    "https://www.popmart.com",
-		ReturnUrl: fmt.Sprintf("https://www.popmart.com/us/checkout?type=normal&orderNo=%s&payType=adyen", order.OrderNumber),
-	}
-
-	payloadBytes, err := json.Marshal(adyenPayload)
-	if err != nil {
-		logger.Error(fmt.Sprintf("Task %s: Failed to Marshal Adyen Payload", task.TaskId))
-		return "", err
-	}
-	return string(payloadBytes), nil
-}
+package desktop
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"popmart/src/middleware/helpers"
+	"popmart/src/middleware/helpers/adyen"
+	api "popmart/src/middleware/helpers/api"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	http "github.com/bogdanfinn/fhttp"
+)
+
+func (om OrderedMap) MarshalJSON() ([]byte, error) {
+	return MarshalOrderedMap([]OrderedKV(om))
+}
+
+func MarshalOrderedMap(pairs []OrderedKV) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, pair := range pairs {
+		keyJSON, _ := json.Marshal(pair.Key)
+		valJSON, _ := json.Marshal(pair.Value)
+
+		buf.Write(keyJSON)
+		buf.WriteByte(':')
+		buf.Write(valJSON)
+
+		if i < len(pairs)-1 {
+			buf.WriteByte(',')
+		}
+	}
+	buf.WriteByte('}')
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+func retry(ctx context.Context, task helpers.Task, logger *helpers.ColorizedLogger, message string, wait time.Duration) {
+	logger.Error(message)
+	task.Status.Retry(message)
+	helpers.Wait(ctx, wait)
+}
+
+func matchesAny(message string, fragments []string) bool {
+	message = strings.ToLower(message)
+	for _, fragment := range fragments {
+		if strings.Contains(message, strings.ToLower(fragment)) {
+			return true
+		}
+	}
+	return false
+}
+
+// PopmartHeaders builds the browser headers every Popmart API request sends, adding authorization when a token is given
+func PopmartHeaders(tdResp api.ApiResp, path, token string) http.Header {
+	headers := http.Header{
+		"language":           {"en"},
+		"sec-ch-ua-platform": {`"Windows"`},
+		"x-project-id":       {"naus"},
+		"x-device-os-type":   {"web"},
+		"sec-ch-ua":          {helpers.SecChUa},
+		"td-session-sign":    {tdResp.SessionSign},
+		"sec-ch-ua-mobile":   {"?0"},
+		"grey-secret":        {"null"},
+		"accept":             {"application/json, text/plain, */*"},
+		"content-type":       {"application/json"},
+		"td-session-query":   {""},
+		"x-client-country":   {"US"},
+		"td-session-key":     {tdResp.SessionKey},
+		"tz":                 {"America/New_York"},
+		"td-session-path":    {path},
+		"country":            {"US"},
+		"x-sign":             {tdResp.Sign},
+		"clientkey":          {ClientKey},
+		"user-agent":         {helpers.UserAgent},
+		"x-client-namespace": {"america"},
+		"origin":             {"https://www.popmart.com"},
+		"sec-fetch-site":     {"same-site"},
+		"sec-fetch-mode":     {"cors"},
+		"sec-fetch-dest":     {"empty"},
+		"referer":            {"https://www.popmart.com/"},
+		"accept-encoding":    {"gzip, deflate, br, zstd"},
+		"accept-language":    {"en-US,en;q=0.9"},
+		"priority":           {"u=1, i"},
+	}
+
+	order := []string{
+		"content-length", "language", "sec-ch-ua-platform", "x-project-id", "x-device-os-type", "sec-ch-ua", "td-session-sign", "sec-ch-ua-mobile", "grey-secret", "accept", "content-type", "td-session-query", "x-client-country", "td-session-key",
+		"tz", "td-session-path", "country", "x-sign", "clientkey", "user-agent", "x-client-namespace", "origin", "sec-fetch-site", "sec-fetch-mode", "sec-fetch-dest", "referer", "accept-encoding", "accept-language", "priority",
+	}
+	if token != "" {
+		headers["authorization"] = []string{fmt.Sprintf("Bearer %s", token)}
+		order = slices.Insert(order, 3, "authorization")
+	}
+
+	headers["Header-Order:"] = order
+	return headers
+}
+
+func AdyenHelper(logger *helpers.ColorizedLogger, task helpers.Task, order OrderDetails, checkoutAttemptId string) (string, error) {
+	adyenData, err := adyen.AdyenEncrypt(logger, task.TaskId, task.Profile.CardNumber, task.Profile.ExpMonth, task.Profile.ExpYear, task.Profile.CVV)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Task %s: Failed To Adyen Encrypt Payment", task.TaskId))
+		return "", err
+	}
+
+	billing := task.Profile.BillingAddress()
+	adyenPayload := AdyenData{
+		PaymentMethod: AdyenPayment{
+			Type:              "scheme",
+			HolderName:        billing.Name,
+			CardNumber:        adyenData.CardNumber,
+			ExpiryMonth:       adyenData.ExpiryMonth,
+			ExpiryYear:        adyenData.ExpiryYear,
+			SecurityCode:      adyenData.SecurityCode,
+			CardBrand:         adyenData.CardType,
+			CheckoutAttemptId: checkoutAttemptId,
+		},
+		BrowserInfo: AdyenBrowser{
+			TimezoneOffset:    240,
+			AcceptHeader:      "*/*",
+			JavascriptEnabled: true,
+			Language:          "nl-NL",
+			JavaEnabled:       true,
+			ScreenHeight:      723,
+			ScreenWidth:       1536,
+			ColorDepth:        24,
+			UserAgent:         helpers.UserAgent,
+		},
+		StorePayment: false,
+		Risk: RiskData{
+			ClientData: adyenData.RiskData,
+		},
+		AdditionalData: Additional{
+			Allow3DS2: "true",
+		},
+		BillingAddress: BillingAddress(billing),
+		Channel:        "Web",
+		Origin:         "https://www.popmart.com",
+		ReturnUrl:      fmt.Sprintf("https://www.popmart.com/us/checkout?type=normal&orderNo=%s&payType=adyen", order.OrderNumber),
+	}
+
+	payloadBytes, err := json.Marshal(adyenPayload)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Task %s: Failed to Marshal Adyen Payload", task.TaskId))
+		return "", err
+	}
+	return string(payloadBytes), nil
+}
+
+// BillingAddress converts a profile address into the shape Adyen checks card holders against, splitting
+// a leading house number off the street like its address form does
+func BillingAddress(address helpers.Address) AdyenAddress {
+	house, street := "", strings.TrimSpace(address.Address1)
+	if number, rest, ok := strings.Cut(street, " "); ok && strings.ContainsAny(number[:1], "0123456789") {
+		house, street = number, strings.TrimSpace(rest)
+	}
+	if address.Address2 != "" {
+		street += " " + strings.TrimSpace(address.Address2)
+	}
+
+	state := address.State
+	if code, ok := helpers.StateAbbreviations[state]; ok {
+		state = code
+	}
+
+	country := CountryCode(address.Country)
+	if country == "" {
+		country = "US"
+	}
+
+	return AdyenAddress{
+		Street:            street,
+		HouseNumberOrName: house,
+		PostalCode:        address.PostCode,
+		City:              address.City,
+		StateOrProvince:   state,
+		Country:           country,
+	}
+}
+
+// ProxyURL turns a host:port:user:pass proxy into the URL request clients take
+func ProxyURL(rawProxy string) (string, error) {
+	parts := strings.Split(rawProxy, ":")
+	if len(parts) != 4 {
+		return "", fmt.Errorf("invalid proxy format")
+	}
+
+	ip, port, user, pass := parts[0], parts[1], parts[2], parts[3]
+	return fmt.Sprintf("http://%s:%s@%s:%s", user, pass, ip, port), nil
+}
+
+// CountryCode turns a profile's country into a two letter code, blank and names for the US give US and anything else gives ""
+func CountryCode(country string) string {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	switch {
+	case slices.Contains([]string{"", "USA", "UNITED STATES", "UNITED STATES OF AMERICA"}, country):
+		return "US"
+	case len(country) == 2 && strings.Trim(country, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") == "":
+		return country
+	}
+	return ""
+}
+
+func ParseProductIds(product ProductDetails) (int64, int64, error) {
+	spuId, err := strconv.ParseInt(product.SpuId, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	skuId, err := strconv.ParseInt(product.SkuId, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return spuId, skuId, nil
+}
+
+// SkuItems builds the cart lines Popmart expects in rate, tax and order payloads
+func SkuItems(products []ProductDetails) ([]OrderedMap, error) {
+	skuItems := make([]OrderedMap, 0, len(products))
+	for _, product := range products {
+		spuId, skuId, err := ParseProductIds(product)
+		if err != nil {
+			return nil, err
+		}
+
+		skuItems = append(skuItems, OrderedMap{
+			{"spuId", spuId},
+			{"skuId", skuId},
+			{"count", product.Quantity},
+			{"skuCount", product.Quantity},
+			{"price", product.Price},
+			{"title", product.SkuTitle},
+			{"spuTitle", product.ProductName},
+			{"discountPrice", product.Price},
+			{"currentSKUInCartNum", product.Quantity},
+		})
+	}
+	return skuItems, nil
+}
+
+// Subtotal is the cart's item total before shipping and tax, in cents
+func Subtotal(products []ProductDetails) int {
+	total := 0
+	for _, product := range products {
+		total += product.Price * product.Quantity
+	}
+	return total
+}
+
+// CartSummary describes a cart for logs and webhooks, multi-item carts list every product with its size and quantity
+func CartSummary(products []ProductDetails) (string, string) {
+	if len(products) == 1 {
+		return products[0].ProductName, products[0].SkuTitle
+	}
+
+	names := make([]string, len(products))
+	sizes := make([]string, len(products))
+	for i, product := range products {
+		names[i] = fmt.Sprintf("%s x%d", product.ProductName, product.Quantity)
+		sizes[i] = product.SkuTitle
+	}
+	return strings.Join(names, ", "), strings.Join(sizes, ", ")
+}
+
+// SelectShipping picks the task's Shipping preference out of the rate response: "cheapest", "fastest", an express
+// code or name, or blank for Popmart's STANDARD method
+func SelectShipping(task helpers.Task, rates RateData, subtotal int) (ShippingOption, error) {
+	options := ShippingOptions(rates, subtotal)
+	if len(options) == 0 {
+		return ShippingOption{}, fmt.Errorf("no shipping methods offered")
+	}
+
+	preference := strings.TrimSpace(task.Shipping)
+	switch strings.ToLower(preference) {
+	case "", "standard":
+		for _, option := range options {
+			if option.Code == "STANDARD" {
+				return option, nil
+			}
+		}
+		return options[0], nil
+	case "cheapest":
+		return slices.MinFunc(options, func(a, b ShippingOption) int { return a.Price - b.Price }), nil
+	case "fastest":
+		// Rates don't carry delivery times, the priciest method is the quickest one Popmart offers
+		return slices.MaxFunc(options, func(a, b ShippingOption) int { return a.Price - b.Price }), nil
+	}
+
+	for _, option := range options {
+		if strings.EqualFold(option.Code, preference) || strings.EqualFold(option.Name, preference) {
+			return option, nil
+		}
+	}
+	return ShippingOption{}, fmt.Errorf("shipping method %s not offered", preference)
+}
+
+// ShippingOptions lists every express method at the price the cart would pay, after discounts and any free shipping it qualifies for
+func ShippingOptions(rates RateData, subtotal int) []ShippingOption {
+	options := make([]ShippingOption, 0, len(rates.ExpressList))
+	for _, express := range rates.ExpressList {
+		option := ShippingOption{Code: express.ExpressCode, Name: express.ExpressName, Price: express.ExpressPrice}
+		if discount, ok := rates.DiscountList[express.ExpressCode]; ok && len(discount.List) > 0 {
+			option.Price = discount.List[0].DiscountAmount
+		}
+
+		// Free shipping promotions are applied to the standard method once the cart reaches their minimum
+		if express.ExpressCode == "STANDARD" {
+			for _, free := range rates.FreeShippingList {
+				if subtotal >= free.Step.Must && free.DiscountAmount < option.Price {
+					option.Price = free.DiscountAmount
+				}
+			}
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+func (d Discount) String() string {
+	if d.Code == "" {
+		return "None"
+	}
+	return d.Code
+}
+
+// Describe is the discount as shown in webhooks, Discord rejects empty fields so no discount reads "None"
+func (d Discount) Describe(amount int) string {
+	if amount <= 0 {
+		return "None"
+	}
+	return fmt.Sprintf("%s (-%s)", d, FormatCents(amount))
+}
+
+// nullable sends a blank discount code as null the way Popmart's checkout does
+func nullable(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// CheckMaxPrice stops the task when any item costs more than its Max Price
+func CheckMaxPrice(task helpers.Task, products []ProductDetails) error {
+	if task.MaxPrice <= 0 {
+		return nil
+	}
+
+	for _, product := range products {
+		if product.Price > task.MaxPrice {
+			return fmt.Errorf("%s costs %s, over the max price of %s", product.ProductName, FormatCents(product.Price), FormatCents(task.MaxPrice))
+		}
+	}
+	return nil
+}
+
+// CheckMaxTotal stops the task when the order total with shipping and tax is over its Max Total
+func CheckMaxTotal(task helpers.Task, total int) error {
+	if task.MaxTotal > 0 && total > task.MaxTotal {
+		return fmt.Errorf("order total %s is over the max total of %s", FormatCents(total), FormatCents(task.MaxTotal))
+	}
+	return nil
+}
+
+func FormatCents(cents int) string {
+	return fmt.Sprintf("$%d.%02d", cents/100, cents%100)
+}
+
+// ParseInput reads a task's Input as a spuId, a popmart.com product URL, or keywords such as "+labubu,+big,-keychain"
+func ParseInput(input string) (ProductQuery, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return ProductQuery{}, fmt.Errorf("input is empty")
+	}
+
+	if RemoveNonDigits(input) == input {
+		return ProductQuery{SpuId: input}, nil
+	}
+
+	if strings.Contains(strings.ToLower(input), "popmart.com") {
+		match := productURL.FindStringSubmatch(input)
+		if match == nil {
+			return ProductQuery{}, fmt.Errorf("no product id found in url %s", input)
+		}
+		return ProductQuery{SpuId: match[1]}, nil
+	}
+
+	var query ProductQuery
+	for _, term := range strings.FieldsFunc(input, func(r rune) bool { return r == ',' }) {
+		term = strings.ToLower(strings.TrimSpace(term))
+		switch {
+		case strings.HasPrefix(term, "-"):
+			if term = strings.TrimSpace(term[1:]); term != "" {
+				query.Negative = append(query.Negative, term)
+			}
+		default:
+			if term = strings.TrimSpace(strings.TrimPrefix(term, "+")); term != "" {
+				query.Positive = append(query.Positive, term)
+			}
+		}
+	}
+
+	if len(query.Positive) == 0 {
+		return ProductQuery{}, fmt.Errorf("keyword input %q needs at least one positive keyword", input)
+	}
+	return query, nil
+}
+
+// Matches reports whether a product title has every positive keyword and none of the negative ones
+func (q ProductQuery) Matches(title string) bool {
+	title = strings.ToLower(title)
+	for _, keyword := range q.Positive {
+		if !strings.Contains(title, keyword) {
+			return false
+		}
+	}
+	return !matchesAny(title, q.Negative)
+}
+
+// Key is the same for every Input naming the same product or keywords, whatever the order or spelling of the Input
+func (q ProductQuery) Key() string {
+	if q.SpuId != "" {
+		return q.SpuId
+	}
+
+	positive, negative := slices.Clone(q.Positive), slices.Clone(q.Negative)
+	slices.Sort(positive)
+	slices.Sort(negative)
+	return "+" + strings.Join(positive, ",+") + "|-" + strings.Join(negative, ",-")
+}
+
+func RemoveNonDigits(input string) string {
+	return nonDigits.ReplaceAllString(input, "")
+}
+
+// NationalPhone strips the profile's dialing code (1 when it has none) off a number written like +1 555 555 5555
+func NationalPhone(profile helpers.Profile) string {
+	phone := RemoveNonDigits(profile.Phone)
+	code := RemoveNonDigits(profile.PhoneCode)
+	if code == "" {
+		code = "1"
+	}
+
+	if len(phone) > 10 && strings.HasPrefix(phone, code) {
+		return phone[len(code):]
+	}
+	return phone
+}
@@ -0,0 +1,88 @@
+package desktop
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ProductQuery
+		wantErr bool
+	}{
+		{input: "1234", want: ProductQuery{SpuId: "1234"}},
+		{input: " 1234 ", want: ProductQuery{SpuId: "1234"}},
+		{input: "https://www.popmart.com/us/products/1234/labubu-big-into-energy", want: ProductQuery{SpuId: "1234"}},
+		{input: "https://www.popmart.com/us/products/1234", want: ProductQuery{SpuId: "1234"}},
+		{input: "HTTPS://WWW.POPMART.COM/US/PRODUCTS/1234/", want: ProductQuery{SpuId: "1234"}},
+		{input: "https://www.popmart.com/us/collection/11", wantErr: true},
+		{input: "labubu", want: ProductQuery{Positive: []string{"labubu"}}},
+		{input: "+Labubu, +Big Into Energy, -Keychain", want: ProductQuery{Positive: []string{"labubu", "big into energy"}, Negative: []string{"keychain"}}},
+		{input: "+labubu,,-", want: ProductQuery{Positive: []string{"labubu"}}},
+		{input: "-keychain", wantErr: true},
+		{input: "", wantErr: true},
+		{input: " , ", wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := ParseInput(test.input)
+		switch {
+		case test.wantErr && err == nil:
+			t.Errorf("ParseInput(%q) = %+v, want an error", test.input, got)
+		case !test.wantErr && err != nil:
+			t.Errorf("ParseInput(%q) failed: %v", test.input, err)
+		case !test.wantErr && (got.SpuId != test.want.SpuId || !slices.Equal(got.Positive, test.want.Positive) || !slices.Equal(got.Negative, test.want.Negative)):
+			t.Errorf("ParseInput(%q) = %+v, want %+v", test.input, got, test.want)
+		}
+	}
+}
+
+func TestProductQueryMatches(t *testing.T) {
+	query, err := ParseInput("+labubu,+big into energy,-keychain")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		title string
+		want  bool
+	}{
+		{title: "THE MONSTERS - Big Into Energy Series - LABUBU Vinyl Plush", want: true},
+		{title: "THE MONSTERS - Big Into Energy Series - LABUBU Keychain", want: false},
+		{title: "THE MONSTERS - Exciting Macaron - LABUBU", want: false},
+	}
+
+	for _, test := range tests {
+		if got := query.Matches(test.title); got != test.want {
+			t.Errorf("Matches(%q) = %v, want %v", test.title, got, test.want)
+		}
+	}
+}
+
+func TestProductQueryKey(t *testing.T) {
+	tests := []struct {
+		a, b string
+		same bool
+	}{
+		{a: "+labubu,+big into energy,-keychain", b: "-Keychain, +Big Into Energy, +Labubu", same: true},
+		{a: "1234", b: "https://www.popmart.com/us/products/1234/labubu", same: true},
+		{a: "+labubu,-keychain", b: "+labubu", same: false},
+		{a: "+labubu,-keychain", b: "+labubu,+keychain", same: false},
+	}
+
+	for _, test := range tests {
+		a, err := ParseInput(test.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ParseInput(test.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if same := a.Key() == b.Key(); same != test.same {
+			t.Errorf("Key(%q) = %q and Key(%q) = %q, want same %v", test.a, a.Key(), test.b, b.Key(), test.same)
+		}
+	}
+}